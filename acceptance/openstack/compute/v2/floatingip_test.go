@@ -93,12 +93,9 @@ func associateFloatingIPFixed(t *testing.T, client *gophercloud.ServiceClient, s
 	}
 
 	var fixedIP string
-	for _, networkAddresses := range server.Addresses[network].([]interface{}) {
-		address := networkAddresses.(map[string]interface{})
-		if address["OS-EXT-IPS:type"] == "fixed" {
-			if address["version"].(float64) == 4 {
-				fixedIP = address["addr"].(string)
-			}
+	for _, address := range server.Addresses[network] {
+		if address.Type == "fixed" && address.Version == 4 {
+			fixedIP = address.Address
 		}
 	}
 
@@ -26,8 +26,9 @@ type AuthOptions struct {
 	Password string `json:"password,omitempty"`
 
 	// At most one of DomainID and DomainName must be provided if using Username
-	// with Identity V3. Otherwise, either are optional.
-	DomainID   string `json:"id,omitempty"`
+	// with Identity V3. Otherwise, either are optional. They identify the
+	// domain of the user.
+	DomainID   string `json:"-"`
 	DomainName string `json:"name,omitempty"`
 
 	// The TenantID and TenantName fields are optional for the Identity V2 API.
@@ -37,21 +38,76 @@ type AuthOptions struct {
 	TenantID   string `json:"tenantId,omitempty"`
 	TenantName string `json:"tenantName,omitempty"`
 
+	// ProjectDomainID and ProjectDomainName identify the domain of the project
+	// named by TenantName in Identity V3, when it isn't the domain of the
+	// user. At most one of them may be provided.
+	ProjectDomainID   string `json:"-"`
+	ProjectDomainName string `json:"-"`
+
+	// Scope selects the scope of the token in Identity V3: a project, a domain,
+	// the system, or no scope at all. When it's set, TenantID, TenantName,
+	// ProjectDomainID and ProjectDomainName are ignored.
+	Scope *AuthScope `json:"-"`
+
 	// AllowReauth should be set to true if you grant permission for Gophercloud to
 	// cache your credentials in memory, and to allow Gophercloud to attempt to
 	// re-authenticate automatically if/when your token expires.  If you set it to
 	// false, it will not cache these settings, but re-authentication will not be
 	// possible.  This setting defaults to false.
 	//
-	// NOTE: The reauth function will try to re-authenticate endlessly if left unchecked.
-	// The way to limit the number of attempts is to provide a custom HTTP client to the provider client
-	// and provide a transport that implements the RoundTripper interface and stores the number of failed retries.
-	// For an example of this, see here: https://github.com/rackspace/rack/blob/1.0.0/auth/clients.go#L311
+	// A request that fails with a 401 triggers at most one re-authentication.
+	// If it fails again, an ErrErrorAfterReauthentication is returned.
 	AllowReauth bool
 
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string
+
+	// ApplicationCredentialID and ApplicationCredentialSecret allow users to
+	// authenticate with an Identity V3 application credential. Instead of its
+	// ID, an application credential may be identified by its
+	// ApplicationCredentialName along with the UserID, or the Username and the
+	// DomainID or DomainName, of its owner. The token is scoped to the project
+	// of the application credential, so TenantID and TenantName are ignored,
+	// and setting Scope is an error.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Passcode is a TOTP passcode of the user, for Identity V3 multi-factor
+	// authentication. It is sent along with the Password, if any. Passcodes
+	// are short-lived, so clients authenticated with one can rarely
+	// re-authenticate.
+	Passcode string `json:"-"`
+
+	// Receipt is the ID of the auth receipt returned, in a
+	// tokens.ErrAdditionalAuthRequired, by a previous Identity V3
+	// authentication attempt that didn't satisfy the multi-factor
+	// authentication rules of the user. The methods provided along with it,
+	// such as a Passcode, complete that attempt.
+	Receipt string `json:"-"`
+}
+
+// AuthScope is the scope of a token in Identity V3. Set exactly one of:
+//
+//   - ProjectID, or ProjectName along with DomainID or DomainName, to scope the
+//     token to a project;
+//   - DomainID or DomainName to scope the token to a domain;
+//   - System to scope the token to the system;
+//   - Unscoped to get a token without any scope, even if the user has a
+//     default project;
+//   - TrustID to act as the trustee of a trust, on behalf of its trustor.
+//     Tokens scoped to a trust can't be used to get other tokens, so the
+//     user must authenticate with a password or an application credential
+//     for re-authentication to work.
+type AuthScope struct {
+	ProjectID   string
+	ProjectName string
+	DomainID    string
+	DomainName  string
+	System      bool
+	Unscoped    bool
+	TrustID     string
 }
 
 // ToTokenV2CreateMap allows AuthOptions to satisfy the AuthOptionsBuilder
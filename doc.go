@@ -60,6 +60,15 @@ of results:
     return true, nil
   })
 
+Requests can be cancelled, or given a deadline, with a context.Context. Call
+WithContext on a ServiceClient or Pager to bind the operations issued through
+it to a context:
+
+  ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+  defer cancel()
+
+  server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
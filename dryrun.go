@@ -0,0 +1,114 @@
+package gophercloud
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrDryRun is returned, along with the synthetic response, for every request
+// a ProviderClient in dry-run mode captures instead of sending. The results of
+// such requests hold it too, so Extract returns it rather than an empty
+// resource.
+var ErrDryRun = errors.New("The request was captured in dry-run mode instead of being sent.")
+
+// CapturedRequest is a request which a ProviderClient in dry-run mode recorded
+// instead of sending it. Secrets are redacted from its headers and body, in
+// the same way as in a RequestLog.
+type CapturedRequest struct {
+	// Method and URL identify the request.
+	Method string
+	URL    string
+
+	// Header holds the headers the request would have been sent with.
+	Header http.Header
+
+	// Body holds the JSON body of the request, if it has one. A raw body, such
+	// as the content of an object, is not captured.
+	Body []byte
+}
+
+// DryRun records the requests which would modify resources instead of sending
+// them. Set a ProviderClient's DryRun field to enable dry-run mode:
+//
+//	plan := new(gophercloud.DryRun)
+//	provider.DryRun = plan
+//	// ... create, update and delete resources ...
+//	for _, r := range plan.Requests() {
+//		fmt.Println(r.Method, r.URL)
+//	}
+//
+// GET and HEAD requests are still sent, so that lookups keep working. Every
+// other request is answered with a synthetic response carrying the first of
+// its expected status codes and an empty body, and with ErrDryRun. A caller
+// which goes on with its plan after a captured request must therefore tell
+// ErrDryRun apart from other errors:
+//
+//	_, err := servers.Create(client, opts).Extract()
+//	if err != nil && err != gophercloud.ErrDryRun {
+//		return err
+//	}
+//
+// Authentication and re-authentication requests are not captured, as long as
+// dry-run mode is enabled once the ProviderClient is authenticated.
+type DryRun struct {
+	mut      sync.Mutex
+	requests []CapturedRequest
+}
+
+// Requests returns the requests captured so far, in the order they were made.
+func (d *DryRun) Requests() []CapturedRequest {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	requests := make([]CapturedRequest, len(d.requests))
+	copy(requests, d.requests)
+	return requests
+}
+
+// Reset discards the requests captured so far.
+func (d *DryRun) Reset() {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+
+	d.requests = nil
+}
+
+// capture records a request and returns the synthetic response to it.
+func (d *DryRun) capture(req *http.Request, body []byte, options *RequestOpts) *http.Response {
+	r := CapturedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: RedactHeader(req.Header),
+	}
+	if body != nil {
+		r.Body = RedactBody(body)
+	}
+
+	d.mut.Lock()
+	d.requests = append(d.requests, r)
+	d.mut.Unlock()
+
+	okCodes := options.OkCodes
+	if okCodes == nil {
+		okCodes = defaultOkCodes(req.Method)
+	}
+	statusCode := http.StatusOK
+	if len(okCodes) > 0 {
+		statusCode = okCodes[0]
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(strings.NewReader("")),
+		ContentLength: 0,
+		Request:       req,
+	}
+}
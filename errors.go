@@ -52,6 +52,13 @@ type ErrUnexpectedResponseCode struct {
 	Expected []int
 	Actual   int
 	Body     []byte
+
+	// RequestID is the ID the service assigned to the request, if any.
+	RequestID string
+
+	// Fault is the description of the failure decoded from Body, if Body
+	// holds a fault in one of the formats used by OpenStack services.
+	Fault *Fault
 }
 
 func (e ErrUnexpectedResponseCode) Error() string {
@@ -62,6 +69,15 @@ func (e ErrUnexpectedResponseCode) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the Fault decoded from the response body, if any, so that it
+// can be retrieved with errors.As.
+func (e ErrUnexpectedResponseCode) Unwrap() error {
+	if e.Fault == nil {
+		return nil
+	}
+	return e.Fault
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -129,6 +145,33 @@ func (e ErrDefault503) Error() string {
 		" overloading or maintenance. This is a temporary condition. Try again later."
 }
 
+// Unwrap returns the ErrUnexpectedResponseCode wrapped by the default error
+// types, so that it can be retrieved with errors.As.
+func (e ErrDefault400) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault401) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault404) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault405) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault408) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault429) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault500) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+func (e ErrDefault503) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
+
 // Err400er is the interface resource error types implement to override the error message
 // from a 400 error.
 type Err400er interface {
@@ -187,6 +230,56 @@ func (e ErrTimeOut) Error() string {
 	return e.choseErrString()
 }
 
+// ErrWaitTimeout is the error type returned by Wait when its context is done,
+// or its timeout expires, before the awaited resource reaches the expected
+// state.
+type ErrWaitTimeout struct {
+	BaseError
+
+	// Last is the last resource observed, if any.
+	Last interface{}
+
+	// Err is the error of the context, such as context.DeadlineExceeded.
+	Err error
+}
+
+func (e ErrWaitTimeout) Error() string {
+	e.DefaultErrString = fmt.Sprintf("A timeout occurred while waiting: %s", e.Err)
+	return e.choseErrString()
+}
+
+func (e ErrWaitTimeout) Unwrap() error {
+	return e.Err
+}
+
+// ErrResourceFailed is the error type returned when a resource being waited on
+// reaches a failure state, such as ERROR, from which it will not reach the
+// expected state.
+type ErrResourceFailed struct {
+	BaseError
+
+	// ResourceType and ID identify the resource, such as "server" and its ID.
+	ResourceType string
+	ID           string
+
+	// Status is the failure status the resource reached.
+	Status string
+
+	// Detail is the reason for the failure given by the service, if any.
+	Detail string
+
+	// Resource is the resource in its failed state.
+	Resource interface{}
+}
+
+func (e ErrResourceFailed) Error() string {
+	e.DefaultErrString = fmt.Sprintf("The %s %s reached the %s status", e.ResourceType, e.ID, e.Status)
+	if e.Detail != "" {
+		e.DefaultErrString += ": " + e.Detail
+	}
+	return e.choseErrString()
+}
+
 // ErrUnableToReauthenticate is the error type returned when reauthentication fails.
 type ErrUnableToReauthenticate struct {
 	BaseError
@@ -198,6 +291,10 @@ func (e ErrUnableToReauthenticate) Error() string {
 	return e.choseErrString()
 }
 
+func (e ErrUnableToReauthenticate) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrErrorAfterReauthentication is the error type returned when reauthentication
 // succeeds, but an error occurs afterword (usually an HTTP error).
 type ErrErrorAfterReauthentication struct {
@@ -210,6 +307,10 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+func (e ErrErrorAfterReauthentication) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -0,0 +1,157 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Fault is the description of a failure found in the body of an unsuccessful
+// response. It is available from the Fault field of an
+// ErrUnexpectedResponseCode, and from any error wrapping one, with errors.As:
+//
+//	var fault *gophercloud.Fault
+//	if errors.As(err, &fault) && fault.Type == "overLimit" {
+//		// The quota was exceeded.
+//	}
+type Fault struct {
+	BaseError
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Code is the error code reported in the body, which is usually the HTTP
+	// status code as well. It is zero if the body doesn't report one.
+	Code int
+
+	// Type identifies the kind of fault. Depending on the service, it is the
+	// key of the fault object, such as "itemNotFound" in Nova and Cinder, an
+	// exception name, such as "PortNotFound" in Neutron and
+	// "StackValidationFailed" in Heat, or a title, such as "Unauthorized" in
+	// Keystone.
+	Type string
+
+	// Message is the human readable description of the fault.
+	Message string
+
+	// Detail holds additional information about the fault, if any.
+	Detail string
+
+	// RequestID is the ID the service assigned to the request, if any.
+	RequestID string
+}
+
+func (e Fault) Error() string {
+	e.DefaultErrString = e.Message
+	if e.Type != "" {
+		e.DefaultErrString = fmt.Sprintf("%s: %s", e.Type, e.Message)
+	}
+	if e.RequestID != "" {
+		e.DefaultErrString += fmt.Sprintf(" (request-id %s)", e.RequestID)
+	}
+	return e.choseErrString()
+}
+
+// parseFault decodes the fault envelopes used by OpenStack services. It
+// returns nil if body doesn't hold a recognized fault.
+func parseFault(statusCode int, requestID string, body []byte) *Fault {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+
+	f := &Fault{
+		StatusCode: statusCode,
+		RequestID:  requestID,
+	}
+
+	// Neutron: {"NeutronError": {"type": "...", "message": "...", "detail": "..."}}
+	if raw, ok := envelope["NeutronError"]; ok {
+		var neutronError struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+			Detail  string `json:"detail"`
+		}
+		if err := json.Unmarshal(raw, &neutronError); err != nil {
+			return nil
+		}
+		f.Type = neutronError.Type
+		f.Message = neutronError.Message
+		f.Detail = neutronError.Detail
+		return f
+	}
+
+	// Keystone: {"error": {"code": 401, "title": "...", "message": "..."}}
+	// Heat: {"code": 400, "title": "...", "explanation": "...",
+	//        "error": {"type": "...", "message": "...", "traceback": "..."}}
+	if raw, ok := envelope["error"]; ok {
+		var keystoneOrHeatError struct {
+			Code      json.RawMessage `json:"code"`
+			Title     string          `json:"title"`
+			Type      string          `json:"type"`
+			Message   string          `json:"message"`
+			Traceback string          `json:"traceback"`
+		}
+		if err := json.Unmarshal(raw, &keystoneOrHeatError); err != nil {
+			return nil
+		}
+
+		f.Code = parseFaultCode(keystoneOrHeatError.Code)
+		if f.Code == 0 {
+			f.Code = parseFaultCode(envelope["code"])
+		}
+		f.Type = keystoneOrHeatError.Type
+		if f.Type == "" {
+			f.Type = keystoneOrHeatError.Title
+		}
+		f.Message = keystoneOrHeatError.Message
+		f.Detail = keystoneOrHeatError.Traceback
+		if f.Message == "" {
+			json.Unmarshal(envelope["explanation"], &f.Message)
+		}
+		return f
+	}
+
+	// Nova, Cinder, Manila and Trove: {"itemNotFound": {"code": 404, "message": "..."}}
+	if len(envelope) == 1 {
+		for k, raw := range envelope {
+			var computeFault struct {
+				Code    json.RawMessage `json:"code"`
+				Message *string         `json:"message"`
+				Details string          `json:"details"`
+			}
+			if err := json.Unmarshal(raw, &computeFault); err != nil || computeFault.Message == nil {
+				return nil
+			}
+			f.Type = k
+			f.Code = parseFaultCode(computeFault.Code)
+			f.Message = *computeFault.Message
+			f.Detail = computeFault.Details
+			return f
+		}
+	}
+
+	return nil
+}
+
+// parseFaultCode reads an error code, which is either a number or a string
+// such as "400 Bad Request".
+func parseFaultCode(raw json.RawMessage) int {
+	if len(raw) == 0 {
+		return 0
+	}
+
+	var code int
+	if err := json.Unmarshal(raw, &code); err == nil {
+		return code
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if fields := strings.Fields(s); len(fields) > 0 {
+			code, _ = strconv.Atoi(fields[0])
+		}
+	}
+	return code
+}
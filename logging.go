@@ -0,0 +1,216 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestLog describes a single HTTP request issued by a ProviderClient and
+// its outcome. Secrets, such as tokens, passwords and Swift temp URL keys, are
+// redacted from the headers and bodies.
+type RequestLog struct {
+	// Method and URL identify the request.
+	Method string
+	URL    string
+
+	// RequestHeader holds the headers the request was sent with.
+	RequestHeader http.Header
+
+	// RequestBody holds the JSON body of the request. It is only set when the
+	// ProviderClient's Debug flag is set.
+	RequestBody []byte
+
+	// StatusCode and ResponseHeader describe the response. They are unset if
+	// no response was received.
+	StatusCode     int
+	ResponseHeader http.Header
+
+	// ResponseBody holds the JSON body of the response. It is only set when
+	// the ProviderClient's Debug flag is set.
+	ResponseBody []byte
+
+	// RequestID is the ID assigned to the request by the service, if any.
+	RequestID string
+
+	// Duration is the time taken to receive the response headers.
+	Duration time.Duration
+
+	// Err is the error returned by the HTTP client, if any.
+	Err error
+}
+
+// Logger receives a RequestLog for every HTTP request issued by a
+// ProviderClient, including authentication, version discovery and pagination
+// requests.
+type Logger interface {
+	LogRequest(RequestLog)
+}
+
+// DefaultLogger is a Logger that writes a line per request, followed by the
+// request and response bodies when they are available, to the standard
+// library's default logger.
+type DefaultLogger struct{}
+
+// LogRequest satisfies the Logger interface.
+func (DefaultLogger) LogRequest(l RequestLog) {
+	if l.Err != nil {
+		log.Printf("[gophercloud] %s %s: %s (%s)", l.Method, l.URL, l.Err, l.Duration)
+		return
+	}
+
+	log.Printf("[gophercloud] %s %s: %d (%s) request-id=%s", l.Method, l.URL, l.StatusCode, l.Duration, l.RequestID)
+	if len(l.RequestBody) > 0 {
+		log.Printf("[gophercloud] request body: %s", l.RequestBody)
+	}
+	if len(l.ResponseBody) > 0 {
+		log.Printf("[gophercloud] response body: %s", l.ResponseBody)
+	}
+}
+
+// RedactedHeaders are the headers whose values are replaced by "***" in a
+// RequestLog.
+var RedactedHeaders = []string{
+	"Authorization",
+	"X-Auth-Token",
+	"X-Subject-Token",
+	"Openstack-Auth-Receipt",
+	"X-Auth-Key",
+	"X-Account-Meta-Temp-Url-Key",
+	"X-Account-Meta-Temp-Url-Key-2",
+	"X-Container-Meta-Temp-Url-Key",
+	"X-Container-Meta-Temp-Url-Key-2",
+}
+
+// redactedFields are the JSON fields whose values are replaced by "***" in a
+// RequestLog, wherever they appear in a body.
+var redactedFields = map[string]bool{
+	"password":      true,
+	"secret":        true,
+	"passcode":      true,
+	"adminPass":     true,
+	"admin_pass":    true,
+	"private_key":   true,
+	"access_token":  true,
+	"id_token":      true,
+	"refresh_token": true,
+}
+
+const redacted = "***"
+
+// logger returns the Logger requests should be reported to, if any.
+func (client *ProviderClient) logger() Logger {
+	if client.Logger != nil {
+		return client.Logger
+	}
+	if client.Debug {
+		return DefaultLogger{}
+	}
+	return nil
+}
+
+// logRequest reports a request to logger. If the response has a JSON body and
+// the client is in debug mode, the body is read, and replaced by a copy so
+// that it can still be consumed by the caller.
+func (client *ProviderClient) logRequest(logger Logger, req *http.Request, reqBody []byte, resp *http.Response, err error, duration time.Duration) {
+	l := RequestLog{
+		Method:        req.Method,
+		URL:           req.URL.String(),
+		RequestHeader: RedactHeader(req.Header),
+		Duration:      duration,
+		Err:           err,
+	}
+
+	if client.Debug && reqBody != nil {
+		l.RequestBody = RedactBody(reqBody)
+	}
+
+	if resp != nil {
+		l.StatusCode = resp.StatusCode
+		l.ResponseHeader = RedactHeader(resp.Header)
+		l.RequestID = RequestID(resp.Header)
+
+		if client.Debug && strings.HasPrefix(resp.Header.Get("Content-Type"), applicationJSON) {
+			body, rerr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			if rerr == nil {
+				l.ResponseBody = RedactBody(body)
+			}
+		}
+	}
+
+	logger.LogRequest(l)
+}
+
+// RequestID returns the ID the service assigned to a request, from whichever
+// header the service uses, given the headers of its response. It returns "" if
+// there is none.
+func RequestID(h http.Header) string {
+	for _, k := range []string{"X-Openstack-Request-Id", "X-Compute-Request-Id", "X-Trans-Id"} {
+		if v := h.Get(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// RedactHeader returns a copy of h in which the values of RedactedHeaders are
+// replaced by "***".
+func RedactHeader(h http.Header) http.Header {
+	r := make(http.Header, len(h))
+	for k, v := range h {
+		r[k] = v
+	}
+	for _, k := range RedactedHeaders {
+		if r.Get(k) != "" {
+			r.Set(k, redacted)
+		}
+	}
+	return r
+}
+
+// RedactBody returns a copy of a JSON body with secrets, such as passwords and
+// token IDs, replaced by "***". It returns nil if body is not valid JSON.
+func RedactBody(body []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+
+	b, err := json.Marshal(redactValue(v, ""))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func redactValue(v interface{}, key string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			switch child.(type) {
+			case map[string]interface{}, []interface{}:
+				// Objects such as the "password" authentication method of
+				// Keystone v3 only hold secrets in their own fields, and
+				// the other ones, such as the name of the user, are worth
+				// logging.
+				v[k] = redactValue(child, k)
+				continue
+			}
+			// The ID of a token, unlike the ID of other resources, is a secret.
+			if redactedFields[k] || (key == "token" && k == "id") {
+				v[k] = redacted
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(child, key)
+		}
+	}
+	return v
+}
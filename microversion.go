@@ -0,0 +1,142 @@
+package gophercloud
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// microversionServices maps the catalog types of the services which support
+// microversions to the service name used in the OpenStack-API-Version header.
+var microversionServices = map[string]string{
+	"compute":                 "compute",
+	"volume":                  "volume",
+	"volumev2":                "volume",
+	"volumev3":                "volume",
+	"block-storage":           "volume",
+	"sharev2":                 "shared-file-system",
+	"shared-file-system":      "shared-file-system",
+	"baremetal":               "baremetal",
+	"baremetal-introspection": "baremetal-introspection",
+	"container-infra":         "container-infra",
+	"placement":               "placement",
+	"key-manager":             "key-manager",
+	"workflowv2":              "workflow",
+	"clustering":              "clustering",
+}
+
+// legacyMicroversionHeaders maps service names to the header in which the
+// microversion was sent before the OpenStack-API-Version header was adopted.
+var legacyMicroversionHeaders = map[string]string{
+	"compute":                 "X-OpenStack-Nova-API-Version",
+	"shared-file-system":      "X-OpenStack-Manila-API-Version",
+	"baremetal":               "X-OpenStack-Ironic-API-Version",
+	"baremetal-introspection": "X-OpenStack-Ironic-Inspector-API-Version",
+}
+
+// microversionService returns the service name used in microversion headers
+// for a service type, and whether the service supports microversions.
+func microversionService(serviceType string) (string, bool) {
+	name, ok := microversionServices[serviceType]
+	return name, ok
+}
+
+// setMicroversionHeader adds the headers requesting the ServiceClient's
+// Microversion to opts. Nothing is added if no Microversion is set, or if the
+// service doesn't support microversions.
+//
+// A ServiceClient without a Type only sends the legacy compute header, which
+// is all older releases of this package sent.
+func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
+	if client.Microversion == "" {
+		return
+	}
+
+	if client.Type == "" {
+		opts.MoreHeaders[legacyMicroversionHeaders["compute"]] = client.Microversion
+		return
+	}
+
+	service, ok := microversionService(client.Type)
+	if !ok {
+		return
+	}
+
+	opts.MoreHeaders["OpenStack-API-Version"] = service + " " + client.Microversion
+	if legacy, ok := legacyMicroversionHeaders[service]; ok {
+		opts.MoreHeaders[legacy] = client.Microversion
+	}
+}
+
+// ResponseMicroversion returns the microversion a service used to serve a
+// request, as advertised in the headers of its response. serviceType is the
+// catalog type of the service, such as "compute" or "volumev3". It returns ""
+// if the response doesn't advertise a microversion.
+//
+// Resource packages can use it to decide which fields a response contains:
+//
+//	version := gophercloud.ResponseMicroversion("compute", r.Header)
+func ResponseMicroversion(serviceType string, h http.Header) string {
+	service, ok := microversionService(serviceType)
+	if !ok {
+		service = serviceType
+	}
+
+	for _, v := range h[http.CanonicalHeaderKey("OpenStack-API-Version")] {
+		// The header may list several services, separated by commas.
+		for _, entry := range strings.Split(v, ",") {
+			fields := strings.Fields(entry)
+			if len(fields) == 2 && fields[0] == service {
+				return fields[1]
+			}
+		}
+	}
+
+	if legacy, ok := legacyMicroversionHeaders[service]; ok {
+		return h.Get(legacy)
+	}
+
+	return ""
+}
+
+// ParseMicroversion splits a microversion, such as "2.53", into its major and
+// minor numbers.
+func ParseMicroversion(version string) (major, minor int, err error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid microversion %q: expected the form X.Y", version)
+	}
+
+	major, err = strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return 0, 0, fmt.Errorf("Invalid microversion %q: bad major version", version)
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return 0, 0, fmt.Errorf("Invalid microversion %q: bad minor version", version)
+	}
+
+	return major, minor, nil
+}
+
+// CompareMicroversions compares two microversions. It returns -1 if a is
+// lower than b, 0 if they are equal, and 1 if a is greater than b.
+func CompareMicroversions(a, b string) (int, error) {
+	aMajor, aMinor, err := ParseMicroversion(a)
+	if err != nil {
+		return 0, err
+	}
+	bMajor, bMinor, err := ParseMicroversion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case aMajor < bMajor, aMajor == bMajor && aMinor < bMinor:
+		return -1, nil
+	case aMajor == bMajor && aMinor == bMinor:
+		return 0, nil
+	}
+	return 1, nil
+}
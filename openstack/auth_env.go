@@ -1,52 +1,54 @@
 package openstack
 
 import (
-	"os"
-
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/internal/authinfo"
 )
 
 var nilOptions = gophercloud.AuthOptions{}
 
 // AuthOptionsFromEnv fills out an identity.AuthOptions structure with the settings found on the various OpenStack
-// OS_* environment variables.  The following variables provide sources of truth: OS_AUTH_URL, OS_USERNAME,
-// OS_PASSWORD, OS_TENANT_ID, and OS_TENANT_NAME.  Of these, OS_USERNAME, OS_PASSWORD, and OS_AUTH_URL must
-// have settings, or an error will result.  OS_TENANT_ID and OS_TENANT_NAME are optional.
+// OS_* environment variables, as set by an openrc file. It reads them the same way as clientconfig.CloudFromEnv, so
+// it returns the same options as its AuthOptions method, but for AllowReauth, which it leaves unset.
+//
+// OS_AUTH_URL must be set. The authentication method is selected by OS_AUTH_TYPE or, if it isn't set, inferred
+// from the credentials that are set:
+//
+//   - an application credential, identified by OS_APPLICATION_CREDENTIAL_ID, or by
+//     OS_APPLICATION_CREDENTIAL_NAME along with its owner, and OS_APPLICATION_CREDENTIAL_SECRET;
+//   - a token, from OS_TOKEN or its legacy name OS_AUTH_TOKEN, if OS_PASSWORD isn't set;
+//   - otherwise, OS_PASSWORD and one of OS_USERNAME and OS_USER_ID.
+//
+// The project is read from OS_PROJECT_ID and OS_PROJECT_NAME, or from their legacy names OS_TENANT_ID and
+// OS_TENANT_NAME, the domain of the project from OS_PROJECT_DOMAIN_ID and OS_PROJECT_DOMAIN_NAME, and the domain of
+// the user from OS_USER_DOMAIN_ID and OS_USER_DOMAIN_NAME. OS_DOMAIN_ID and OS_DOMAIN_NAME stand for the domain of
+// the user and of the project when those aren't set, and scope the token to the domain when no project is set.
+// OS_DEFAULT_DOMAIN is the ID of the domain used when no other domain is set. Setting OS_SYSTEM_SCOPE requests a
+// system-scoped token instead.
+//
+// AuthOptionsFromEnv only returns the credentials and scope. It ignores the variables which select the endpoints and
+// configure TLS: OS_REGION_NAME, OS_INTERFACE, OS_CACERT, OS_CERT, OS_KEY and OS_INSECURE. Callers that honour them
+// must read the environment with clientconfig.CloudFromEnv instead, and use the EndpointOpts and TLSConfig methods of
+// the Cloud it returns:
+//
+//	cloud := clientconfig.CloudFromEnv()
+//	ao, err := cloud.AuthOptions()
+//	tlsConfig, err := cloud.TLSConfig()
+//	eo := cloud.EndpointOpts()
 func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
-	authURL := os.Getenv("OS_AUTH_URL")
-	username := os.Getenv("OS_USERNAME")
-	userID := os.Getenv("OS_USERID")
-	password := os.Getenv("OS_PASSWORD")
-	tenantID := os.Getenv("OS_TENANT_ID")
-	tenantName := os.Getenv("OS_TENANT_NAME")
-	domainID := os.Getenv("OS_DOMAIN_ID")
-	domainName := os.Getenv("OS_DOMAIN_NAME")
-
-	if authURL == "" {
-		err := gophercloud.ErrMissingInput{Argument: "authURL"}
-		return nilOptions, err
+	a := authinfo.FromEnv()
+	authType := a.AuthTypeFromEnv()
+
+	ao, err := a.AuthOptions(authType)
+	if _, ok := err.(authinfo.ErrUnsupportedAuthType); ok {
+		err = gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "authType"},
+			Value:           authType,
+		}
 	}
-
-	if username == "" && userID == "" {
-		err := gophercloud.ErrMissingInput{Argument: "username"}
+	if err != nil {
 		return nilOptions, err
 	}
 
-	if password == "" {
-		err := gophercloud.ErrMissingInput{Argument: "password"}
-		return nilOptions, err
-	}
-
-	ao := gophercloud.AuthOptions{
-		IdentityEndpoint: authURL,
-		UserID:           userID,
-		Username:         username,
-		Password:         password,
-		TenantID:         tenantID,
-		TenantName:       tenantName,
-		DomainID:         domainID,
-		DomainName:       domainName,
-	}
-
 	return ao, nil
 }
@@ -1,6 +1,9 @@
 package snapshots
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -23,7 +26,7 @@ type Snapshot struct {
 	Bootable string `json:"bootable"`
 
 	// Date created.
-	CreatedAt gophercloud.JSONRFC3339Milli `json:"created_at"`
+	CreatedAt time.Time `json:"created_at"`
 
 	// Display description.
 	Description string `json:"display_discription"`
@@ -47,6 +50,24 @@ type Snapshot struct {
 	Size int `json:"size"`
 }
 
+// UnmarshalJSON decodes a Snapshot, parsing CreatedAt with
+// gophercloud.JSONISO8601. A struct embedding Snapshot inherits this method, so
+// it must decode its own fields separately.
+func (r *Snapshot) UnmarshalJSON(b []byte) error {
+	type tmp Snapshot
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONISO8601 `json:"created_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Snapshot(s.tmp)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	return nil
+}
+
 // CreateResult contains the response body and error from a Create request.
 type CreateResult struct {
 	commonResult
@@ -1,22 +1,59 @@
 package snapshots
 
 import (
+	"context"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 )
 
+// FailureStatuses are the statuses of a snapshot which has failed. Waiting for
+// any other status stops as soon as a snapshot reaches one of them.
+var FailureStatuses = []string{"error", "error_deleting"}
+
 // WaitForStatus will continually poll the resource, checking for a particular
 // status. It will do this for the amount of seconds defined.
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
-		current, err := Get(c, id).Extract()
+	// As with gophercloud.WaitFor, a negative number of seconds waits forever.
+	ctx := context.Background()
+	if secs >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(secs)*time.Second)
+		defer cancel()
+	}
+	_, err := WaitForStatusContext(ctx, c, id, status, gophercloud.WaitOpts{})
+	return err
+}
+
+// WaitForStatusContext polls a snapshot until it transitions to the specified
+// status, and returns it. If the snapshot reaches one of FailureStatuses
+// instead, it returns a gophercloud.ErrResourceFailed. If ctx is done first,
+// it returns a gophercloud.ErrWaitTimeout holding the last snapshot observed.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string, opts gophercloud.WaitOpts) (*Snapshot, error) {
+	r, err := gophercloud.Wait(ctx, opts, func(ctx context.Context) (interface{}, bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
 		if current.Status == status {
-			return true, nil
+			return current, true, nil
 		}
 
-		return false, nil
+		for _, s := range FailureStatuses {
+			if current.Status == s {
+				return current, false, gophercloud.ErrResourceFailed{
+					ResourceType: "snapshot",
+					ID:           id,
+					Status:       current.Status,
+					Resource:     current,
+				}
+			}
+		}
+
+		return current, false, nil
 	})
+
+	snapshot, _ := r.(*Snapshot)
+	return snapshot, err
 }
@@ -1,6 +1,9 @@
 package volumes
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -18,7 +21,7 @@ type Volume struct {
 	// Indicates whether this is a bootable volume.
 	Bootable string `json:"bootable"`
 	// The date when this volume was created.
-	CreatedAt gophercloud.JSONRFC3339Milli `json:"created_at"`
+	CreatedAt time.Time `json:"created_at"`
 	// Human-readable description for the volume.
 	Description string `json:"display_description"`
 	// The type of volume to create, either SATA or SSD.
@@ -35,6 +38,24 @@ type Volume struct {
 	Size int `json:"size"`
 }
 
+// UnmarshalJSON decodes a Volume, parsing CreatedAt with
+// gophercloud.JSONISO8601. A struct embedding Volume inherits this method, so
+// it must decode its own fields separately.
+func (r *Volume) UnmarshalJSON(b []byte) error {
+	type tmp Volume
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONISO8601 `json:"created_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Volume(s.tmp)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	return nil
+}
+
 // CreateResult contains the response body and error from a Create request.
 type CreateResult struct {
 	commonResult
@@ -40,7 +40,9 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 
 // Delete will delete the volume type with the provided ID.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	_, r.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -45,7 +45,8 @@ func Attach(client *gophercloud.ServiceClient, id string, opts AttachOptsBuilder
 		return
 	}
 	_, r.Err = client.Post(attachURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{202},
+		OkCodes:           []int{202},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -74,7 +75,8 @@ func Detach(client *gophercloud.ServiceClient, id string, opts DetachOptsBuilder
 		return
 	}
 	_, r.Err = client.Post(detachURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{202},
+		OkCodes:           []int{202},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -83,7 +85,8 @@ func Detach(client *gophercloud.ServiceClient, id string, opts DetachOptsBuilder
 func Reserve(client *gophercloud.ServiceClient, id string) (r ReserveResult) {
 	b := map[string]interface{}{"os-reserve": make(map[string]interface{})}
 	_, r.Err = client.Post(reserveURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{200, 201, 202},
+		OkCodes:           []int{200, 201, 202},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -92,7 +95,8 @@ func Reserve(client *gophercloud.ServiceClient, id string) (r ReserveResult) {
 func Unreserve(client *gophercloud.ServiceClient, id string) (r UnreserveResult) {
 	b := map[string]interface{}{"os-unreserve": make(map[string]interface{})}
 	_, r.Err = client.Post(unreserveURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{200, 201, 202},
+		OkCodes:           []int{200, 201, 202},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -168,7 +172,8 @@ func TerminateConnection(client *gophercloud.ServiceClient, id string, opts Term
 		return
 	}
 	_, r.Err = client.Post(teminateConnectionURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{202},
+		OkCodes:           []int{202},
+		DrainResponseBody: true,
 	})
 	return
 }
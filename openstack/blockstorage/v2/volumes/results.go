@@ -1,17 +1,37 @@
 package volumes
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
 type Attachment struct {
-	ID         string                          `json:"id"`
-	VolumeID   string                          `json:"volume_id"`
-	ServerID   string                          `json:"instance_uuid"`
-	HostName   string                          `json:"attached_host"`
-	Device     string                          `json:"mountpoint"`
-	AttachedAt gophercloud.JSONRFC3339MilliNoZ `json:"attach_time"`
+	ID         string    `json:"id"`
+	VolumeID   string    `json:"volume_id"`
+	ServerID   string    `json:"instance_uuid"`
+	HostName   string    `json:"attached_host"`
+	Device     string    `json:"mountpoint"`
+	AttachedAt time.Time `json:"attach_time"`
+}
+
+// UnmarshalJSON decodes an Attachment, parsing AttachedAt with
+// gophercloud.JSONISO8601.
+func (r *Attachment) UnmarshalJSON(b []byte) error {
+	type tmp Attachment
+	var s struct {
+		tmp
+		AttachedAt gophercloud.JSONISO8601 `json:"attach_time"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Attachment(s.tmp)
+	r.AttachedAt = time.Time(s.AttachedAt)
+	return nil
 }
 
 // Volume contains all the information associated with an OpenStack Volume.
@@ -25,9 +45,9 @@ type Volume struct {
 	// AvailabilityZone is which availability zone the volume is in.
 	AvailabilityZone string `json:"availability_zone"`
 	// The date when this volume was created.
-	CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	CreatedAt time.Time `json:"created_at"`
 	// The date when this volume was last updated
-	UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 	// Instances onto which the volume is attached.
 	Attachments []Attachment `json:"attachments"`
 	// Human-readable display name for the volume.
@@ -56,6 +76,27 @@ type Volume struct {
 	Multiattach bool `json:"multiattach"`
 }
 
+// UnmarshalJSON decodes a Volume, parsing CreatedAt and UpdatedAt with
+// gophercloud.JSONISO8601. A struct embedding Volume, such as one adding the
+// fields of an extension, inherits this method, so it must decode its own
+// fields separately.
+func (r *Volume) UnmarshalJSON(b []byte) error {
+	type tmp Volume
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONISO8601 `json:"created_at"`
+		UpdatedAt gophercloud.JSONISO8601 `json:"updated_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Volume(s.tmp)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+	return nil
+}
+
 /*
 THESE BELONG IN EXTENSIONS:
 // ReplicationDriverData contains data about the replication driver.
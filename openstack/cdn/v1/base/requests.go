@@ -12,8 +12,9 @@ func Get(c *gophercloud.ServiceClient) (r GetResult) {
 // Ping retrieves a ping to the server.
 func Ping(c *gophercloud.ServiceClient) (r PingResult) {
 	_, r.Err = c.Get(pingURL(c), nil, &gophercloud.RequestOpts{
-		OkCodes:     []int{204},
-		MoreHeaders: map[string]string{"Accept": ""},
+		OkCodes:           []int{204},
+		MoreHeaders:       map[string]string{"Accept": ""},
+		DrainResponseBody: true,
 	})
 	return
 }
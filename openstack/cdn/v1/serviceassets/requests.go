@@ -46,6 +46,8 @@ func Delete(c *gophercloud.ServiceClient, idOrURL string, opts DeleteOptsBuilder
 		}
 		url += q
 	}
-	_, r.Err = c.Delete(url, nil)
+	_, r.Err = c.Delete(url, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
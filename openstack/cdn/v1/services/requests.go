@@ -88,7 +88,9 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 		r.Err = err
 		return r
 	}
-	resp, err := c.Post(createURL(c), &b, nil, nil)
+	resp, err := c.Post(createURL(c), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	r.Header = resp.Header
 	r.Err = err
 	return
@@ -261,8 +263,9 @@ func Update(c *gophercloud.ServiceClient, idOrURL string, opts UpdateOpts) (r Up
 	}
 
 	resp, err := c.Request("PATCH", url, &gophercloud.RequestOpts{
-		JSONBody: &b,
-		OkCodes:  []int{202},
+		JSONBody:          &b,
+		OkCodes:           []int{202},
+		DrainResponseBody: true,
 	})
 	r.Header = resp.Header
 	r.Err = err
@@ -280,6 +283,8 @@ func Delete(c *gophercloud.ServiceClient, idOrURL string) (r DeleteResult) {
 	} else {
 		url = deleteURL(c, idOrURL)
 	}
-	_, r.Err = c.Delete(url, nil)
+	_, r.Err = c.Delete(url, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
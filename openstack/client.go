@@ -1,11 +1,13 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
 	"github.com/gophercloud/gophercloud"
 	tokens2 "github.com/gophercloud/gophercloud/openstack/identity/v2/tokens"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
 	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/openstack/utils"
 )
@@ -31,17 +33,13 @@ func NewClient(endpoint string) (*gophercloud.ProviderClient, error) {
 	endpoint = gophercloud.NormalizeURL(endpoint)
 	base = gophercloud.NormalizeURL(base)
 
+	p := new(gophercloud.ProviderClient)
+	p.IdentityBase = base
 	if hadPath {
-		return &gophercloud.ProviderClient{
-			IdentityBase:     base,
-			IdentityEndpoint: endpoint,
-		}, nil
+		p.IdentityEndpoint = endpoint
 	}
 
-	return &gophercloud.ProviderClient{
-		IdentityBase:     base,
-		IdentityEndpoint: "",
-	}, nil
+	return p, nil
 }
 
 // AuthenticatedClient logs in to an OpenStack cloud found at the identity endpoint specified by options, acquires a token, and
@@ -75,9 +73,9 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 	switch chosen.ID {
 	case v20:
-		return v2auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		return v2auth(context.Background(), client, endpoint, options, gophercloud.EndpointOpts{})
 	case v30:
-		return v3auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		return v3auth(context.Background(), client, endpoint, options, gophercloud.EndpointOpts{})
 	default:
 		// The switch statement must be out of date from the versions list.
 		return fmt.Errorf("Unrecognized identity version: %s", chosen.ID)
@@ -86,10 +84,10 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v2auth(client, "", options, eo)
+	return v2auth(context.Background(), client, "", options, eo)
 }
 
-func v2auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
+func v2auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
 	v2Client, err := NewIdentityV2(client, eo)
 	if err != nil {
 		return err
@@ -109,7 +107,7 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		TokenID:          options.TokenID,
 	}
 
-	result := tokens2.Create(v2Client, v2Opts)
+	result := tokens2.Create(v2Client.WithContext(ctx), v2Opts)
 
 	token, err := result.ExtractToken()
 	if err != nil {
@@ -122,25 +120,30 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 	}
 
 	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v2auth(client, endpoint, options, eo)
+		client.ReauthFunc = func(ctx context.Context) error {
+			tac := throwawayClient(client)
+			if err := v2auth(ctx, tac, endpoint, options, eo); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(tac)
+			return nil
 		}
 	}
-	client.TokenID = token.ID
-	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
+	client.SetEndpointLocator(func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
-	}
+	})
 
 	return nil
 }
 
 // AuthenticateV3 explicitly authenticates against the identity v3 service.
 func AuthenticateV3(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v3auth(client, "", options, eo)
+	return v3auth(context.Background(), client, "", options, eo)
 }
 
-func v3auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
+func v3auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
 	// Override the generated service endpoint with the one returned by the version endpoint.
 	v3Client, err := NewIdentityV3(client, eo)
 	if err != nil {
@@ -156,7 +159,27 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 	v3Options := options
 
 	var scope *tokens3.Scope
-	if options.TenantID != "" {
+	if options.ApplicationCredentialID != "" || options.ApplicationCredentialName != "" {
+		// An application credential is scoped to its own project, so it can't
+		// be combined with another scope.
+		if options.Scope != nil {
+			return tokens3.ErrScopeWithAppCred{}
+		}
+		v3Options.TenantID = ""
+		v3Options.TenantName = ""
+	} else if options.Scope != nil {
+		scope = &tokens3.Scope{
+			ProjectID:   options.Scope.ProjectID,
+			ProjectName: options.Scope.ProjectName,
+			DomainID:    options.Scope.DomainID,
+			DomainName:  options.Scope.DomainName,
+			System:      options.Scope.System,
+			Unscoped:    options.Scope.Unscoped,
+			TrustID:     options.Scope.TrustID,
+		}
+		v3Options.TenantID = ""
+		v3Options.TenantName = ""
+	} else if options.TenantID != "" {
 		scope = &tokens3.Scope{
 			ProjectID: options.TenantID,
 		}
@@ -164,29 +187,39 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		v3Options.TenantName = ""
 	} else {
 		if options.TenantName != "" {
+			// The project is in the domain of the user, unless told otherwise.
 			scope = &tokens3.Scope{
 				ProjectName: options.TenantName,
 				DomainID:    options.DomainID,
 				DomainName:  options.DomainName,
 			}
+			if options.ProjectDomainID != "" || options.ProjectDomainName != "" {
+				scope.DomainID = options.ProjectDomainID
+				scope.DomainName = options.ProjectDomainName
+			}
 			v3Options.TenantName = ""
 		}
 	}
 
 	v3Opts := tokens3.AuthOptions{
-		IdentityEndpoint: options.IdentityEndpoint,
-		Username:         options.Username,
-		UserID:           options.UserID,
-		Password:         options.Password,
-		DomainID:         options.DomainID,
-		DomainName:       options.DomainName,
-		TenantID:         options.TenantID,
-		TenantName:       options.TenantName,
-		AllowReauth:      options.AllowReauth,
-		TokenID:          options.TokenID,
+		IdentityEndpoint:            options.IdentityEndpoint,
+		Username:                    options.Username,
+		UserID:                      options.UserID,
+		Password:                    options.Password,
+		DomainID:                    options.DomainID,
+		DomainName:                  options.DomainName,
+		TenantID:                    v3Options.TenantID,
+		TenantName:                  v3Options.TenantName,
+		AllowReauth:                 options.AllowReauth,
+		TokenID:                     options.TokenID,
+		ApplicationCredentialID:     options.ApplicationCredentialID,
+		ApplicationCredentialName:   options.ApplicationCredentialName,
+		ApplicationCredentialSecret: options.ApplicationCredentialSecret,
+		Passcode:                    options.Passcode,
+		Receipt:                     options.Receipt,
 	}
 
-	result := tokens3.Create(v3Client, v3Opts, scope)
+	result := tokens3.Create(v3Client.WithContext(ctx), v3Opts, scope)
 
 	token, err := result.ExtractToken()
 	if err != nil {
@@ -198,21 +231,180 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	client.TokenID = token.ID
+	client.SetToken(token.ID)
+	client.SetTokenExpiresAt(token.ExpiresAt)
 
 	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v3auth(client, endpoint, options, eo)
+		// An auth receipt is only valid for a few minutes, so re-authentication
+		// starts over with all the methods instead.
+		reauthOptions := options
+		reauthOptions.Receipt = ""
+
+		client.ReauthFunc = func(ctx context.Context) error {
+			tac := throwawayClient(client)
+			if err := v3auth(ctx, tac, endpoint, reauthOptions, eo); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(tac)
+			return nil
 		}
 	}
-	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+	client.SetEndpointLocator(func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
+	})
+
+	return nil
+}
+
+// AuthenticateV3Federated authenticates a federated user against the identity
+// v3 service: the credentials in options are exchanged at the identity provider
+// and protocol it names for an unscoped token, which is then exchanged for a
+// token of options.Scope, if set.
+//
+// If options.AllowReauth is set, the whole federated authentication is gone
+// through again once the token is rejected.
+func AuthenticateV3Federated(client *gophercloud.ProviderClient, options federation.AuthOptions, eo gophercloud.EndpointOpts) error {
+	return v3federatedauth(context.Background(), client, options, eo)
+}
+
+func v3federatedauth(ctx context.Context, client *gophercloud.ProviderClient, options federation.AuthOptions, eo gophercloud.EndpointOpts) error {
+	v3Client, err := NewIdentityV3(client, eo)
+	if err != nil {
+		return err
+	}
+
+	result := federation.Create(v3Client.WithContext(ctx), options)
+
+	token, err := result.ExtractToken()
+	if err != nil {
+		return err
+	}
+
+	if options.Scope != nil {
+		// The unscoped token is only used to get the scoped one.
+		scoped := gophercloud.AuthOptions{
+			TokenID: token.ID,
+			Scope:   options.Scope,
+		}
+		if err := v3auth(ctx, client, "", scoped, eo); err != nil {
+			return err
+		}
+	} else {
+		catalog, err := result.ExtractServiceCatalog()
+		if err != nil {
+			return err
+		}
+
+		client.SetToken(token.ID)
+		client.SetTokenExpiresAt(token.ExpiresAt)
+		client.SetEndpointLocator(func(opts gophercloud.EndpointOpts) (string, error) {
+			return V3EndpointURL(catalog, opts)
+		})
+	}
+
+	if options.AllowReauth {
+		client.ReauthFunc = func(ctx context.Context) error {
+			tac := throwawayClient(client)
+			if err := v3federatedauth(ctx, tac, options, eo); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(tac)
+			return nil
+		}
 	}
 
 	return nil
 }
 
+// RescopeV3 uses the token of an authenticated ProviderClient to get a token
+// with another scope, such as another project, from the identity v3 service,
+// without sending the credentials again. It returns a new ProviderClient which
+// holds the new token, locates endpoints with the service catalog of its scope
+// and shares the HTTP settings of client. client itself is left unchanged.
+//
+// A token obtained from another token expires with it. When the token of the
+// returned ProviderClient is rejected, it is obtained again from the current
+// token of client, which is first re-authenticated if needed and possible.
+// Since renewing it ahead of time wouldn't extend its expiry, the returned
+// ProviderClient has no TokenRenewalMargin.
+func RescopeV3(client *gophercloud.ProviderClient, scope gophercloud.AuthScope, eo gophercloud.EndpointOpts) (*gophercloud.ProviderClient, error) {
+	rescoped := &gophercloud.ProviderClient{
+		IdentityBase:     client.IdentityBase,
+		IdentityEndpoint: client.IdentityEndpoint,
+		HTTPClient:       client.HTTPClient,
+		UserAgent:        client.UserAgent,
+		RetryPolicy:      client.RetryPolicy,
+		BeforeRequest:    client.BeforeRequest,
+		AfterResponse:    client.AfterResponse,
+		Logger:           client.Logger,
+		Debug:            client.Debug,
+	}
+
+	if err := rescopeV3(context.Background(), rescoped, client, scope, eo); err != nil {
+		return nil, err
+	}
+
+	// Authentication requests are sent even in dry-run mode.
+	rescoped.DryRun = client.DryRun
+
+	rescoped.ReauthFunc = func(ctx context.Context) error {
+		tac := throwawayClient(rescoped)
+		if err := rescopeV3(ctx, tac, client, scope, eo); err != nil {
+			return err
+		}
+		rescoped.CopyTokenFrom(tac)
+		return nil
+	}
+
+	return rescoped, nil
+}
+
+// rescopeV3 authenticates rescoped with the token of client and scope. If the
+// token of client is rejected, client is re-authenticated and the request is
+// sent again.
+func rescopeV3(ctx context.Context, rescoped, client *gophercloud.ProviderClient, scope gophercloud.AuthScope, eo gophercloud.EndpointOpts) error {
+	token := client.Token()
+	options := gophercloud.AuthOptions{
+		TokenID: token,
+		Scope:   &scope,
+	}
+
+	err := v3auth(ctx, rescoped, "", options, eo)
+	switch err.(type) {
+	case gophercloud.ErrDefault401, gophercloud.ErrDefault404:
+		// Keystone answers 404 when the token to authenticate with has expired.
+		if client.ReauthFunc == nil {
+			return err
+		}
+		if err := client.Reauthenticate(ctx, token); err != nil {
+			return err
+		}
+		options.TokenID = client.Token()
+		return v3auth(ctx, rescoped, "", options, eo)
+	}
+	return err
+}
+
+// throwawayClient returns a ProviderClient, without a token, that shares the HTTP
+// settings, hooks and logger of client and that re-authentication can be performed
+// with. It can't re-authenticate itself, so a rejected authentication request fails
+// instead of recursing, and the original client keeps serving requests with its
+// current token until the new one is copied back. Authentication requests are sent
+// even when client is in dry-run mode.
+func throwawayClient(client *gophercloud.ProviderClient) *gophercloud.ProviderClient {
+	return &gophercloud.ProviderClient{
+		IdentityBase:     client.IdentityBase,
+		IdentityEndpoint: client.IdentityEndpoint,
+		HTTPClient:       client.HTTPClient,
+		UserAgent:        client.UserAgent,
+		RetryPolicy:      client.RetryPolicy,
+		BeforeRequest:    client.BeforeRequest,
+		AfterResponse:    client.AfterResponse,
+		Logger:           client.Logger,
+		Debug:            client.Debug,
+	}
+}
+
 // NewIdentityV2 creates a ServiceClient that may be used to interact with the v2 identity service.
 func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	v2Endpoint := client.IdentityBase + "v2.0/"
@@ -227,6 +419,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v2Endpoint,
+		Type:           "identity",
 		//Endpoint: url,
 	}, nil
 }
@@ -245,6 +438,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       v3Endpoint,
+		Type:           "identity",
 		//Endpoint: url,
 	}, nil
 }
@@ -252,27 +446,27 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 // NewObjectStorageV1 creates a ServiceClient that may be used with the v1 object storage package.
 func NewObjectStorageV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("object-store")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "object-store"}, nil
 }
 
 // NewComputeV2 creates a ServiceClient that may be used with the v2 compute package.
 func NewComputeV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("compute")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "compute"}, nil
 }
 
 // NewNetworkV2 creates a ServiceClient that may be used with the v2 network package.
 func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("network")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
@@ -280,56 +474,57 @@ func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 		ProviderClient: client,
 		Endpoint:       url,
 		ResourceBase:   url + "v2.0/",
+		Type:           "network",
 	}, nil
 }
 
 // NewBlockStorageV1 creates a ServiceClient that may be used to access the v1 block storage service.
 func NewBlockStorageV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("volume")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "volume"}, nil
 }
 
 // NewBlockStorageV2 creates a ServiceClient that may be used to access the v2 block storage service.
 func NewBlockStorageV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("volumev2")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "volumev2"}, nil
 }
 
 // NewCDNV1 creates a ServiceClient that may be used to access the OpenStack v1
 // CDN service.
 func NewCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("cdn")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "cdn"}, nil
 }
 
 // NewOrchestrationV1 creates a ServiceClient that may be used to access the v1 orchestration service.
 func NewOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("orchestration")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "orchestration"}, nil
 }
 
 // NewDBV1 creates a ServiceClient that may be used to access the v1 DB service.
 func NewDBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	eo.ApplyDefaults("database")
-	url, err := client.EndpointLocator(eo)
+	url, err := client.LocateEndpoint(eo)
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "database"}, nil
 }
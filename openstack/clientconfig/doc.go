@@ -0,0 +1,35 @@
+/*
+Package clientconfig reads the configuration of clouds from clouds.yaml files,
+in the same way as the other OpenStack clients, and turns it into the options
+used to authenticate and to create service clients.
+
+clouds.yaml is looked up in the current directory, in ~/.config/openstack and
+in /etc/openstack, unless the OS_CLIENT_CONFIG_FILE environment variable names
+another file. The settings of a cloud are merged from, by increasing
+precedence:
+
+  - the profile it names, from clouds-public.yaml;
+  - its entry in clouds.yaml;
+  - its entry in secure.yaml, which usually holds passwords.
+
+When no cloud is named, the configuration is read from the OS_* environment
+variables set by openrc files instead.
+
+Example to authenticate against the cloud named by OS_CLOUD, or configured by
+the environment
+
+	provider, err := clientconfig.AuthenticatedClient(nil)
+
+	cloud, err := clientconfig.GetCloud(nil)
+	computeClient, err := openstack.NewComputeV2(provider, cloud.EndpointOpts())
+
+Example to read the options of a named cloud
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{
+		Cloud: "mycloud",
+	})
+
+	authOpts, err := cloud.AuthOptions()
+	tlsConfig, err := cloud.TLSConfig()
+*/
+package clientconfig
@@ -0,0 +1,40 @@
+package clientconfig
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/internal/authinfo"
+)
+
+// CloudFromEnv reads the configuration of a cloud from the OS_* environment
+// variables, as set by an openrc file, in the same way as the OpenStack
+// command-line client. Legacy names, such as OS_TENANT_NAME and
+// OS_ENDPOINT_TYPE, are read when the current ones aren't set.
+//
+// Unless OS_AUTH_TYPE is set, the authentication method is inferred from the
+// credentials: an application credential is used if OS_APPLICATION_CREDENTIAL_ID
+// or OS_APPLICATION_CREDENTIAL_NAME is set, then a token if OS_TOKEN is set and
+// OS_PASSWORD isn't, and a password otherwise. The credentials are read the
+// same way as by openstack.AuthOptionsFromEnv.
+func CloudFromEnv() *Cloud {
+	a := authinfo.FromEnv()
+	cloud := &Cloud{
+		AuthInfo:           AuthInfo(a),
+		AuthType:           a.AuthTypeFromEnv(),
+		RegionName:         authinfo.Getenv("OS_REGION_NAME", "OS_REGION"),
+		Interface:          os.Getenv("OS_INTERFACE"),
+		EndpointType:       os.Getenv("OS_ENDPOINT_TYPE"),
+		IdentityAPIVersion: os.Getenv("OS_IDENTITY_API_VERSION"),
+		CACertFile:         os.Getenv("OS_CACERT"),
+		ClientCertFile:     os.Getenv("OS_CERT"),
+		ClientKeyFile:      os.Getenv("OS_KEY"),
+	}
+
+	if insecure, err := strconv.ParseBool(os.Getenv("OS_INSECURE")); err == nil {
+		verify := !insecure
+		cloud.Verify = &verify
+	}
+
+	return cloud
+}
@@ -0,0 +1,46 @@
+package clientconfig
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrNoCloudsFile is the error when no clouds.yaml file could be found.
+type ErrNoCloudsFile struct{ gophercloud.BaseError }
+
+func (e ErrNoCloudsFile) Error() string {
+	return "No clouds.yaml file could be found"
+}
+
+// ErrCloudNotFound is the error when a cloud isn't defined in clouds.yaml.
+type ErrCloudNotFound struct {
+	gophercloud.BaseError
+	Cloud string
+}
+
+func (e ErrCloudNotFound) Error() string {
+	return fmt.Sprintf("Cloud %s could not be found in clouds.yaml", e.Cloud)
+}
+
+// ErrProfileNotFound is the error when the profile of a cloud isn't defined in
+// clouds-public.yaml.
+type ErrProfileNotFound struct {
+	gophercloud.BaseError
+	Profile string
+}
+
+func (e ErrProfileNotFound) Error() string {
+	return fmt.Sprintf("Profile %s could not be found in clouds-public.yaml", e.Profile)
+}
+
+// ErrUnsupportedAuthType is the error when the authentication method of a
+// cloud isn't supported.
+type ErrUnsupportedAuthType struct {
+	gophercloud.BaseError
+	AuthType string
+}
+
+func (e ErrUnsupportedAuthType) Error() string {
+	return fmt.Sprintf("Unsupported auth_type: %s", e.AuthType)
+}
@@ -0,0 +1,164 @@
+package clientconfig
+
+import (
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+)
+
+// ClientOpts selects the cloud to read the configuration of.
+type ClientOpts struct {
+	// Cloud is the name of the cloud in clouds.yaml. It defaults to the value
+	// of the OS_CLOUD environment variable.
+	Cloud string
+
+	// CloudsFile, SecureFile and PublicCloudsFile are the paths to the
+	// clouds.yaml, secure.yaml and clouds-public.yaml files. They default to
+	// the values of the OS_CLIENT_CONFIG_FILE, OS_CLIENT_SECURE_FILE and
+	// OS_CLIENT_PUBLIC_FILE environment variables, and then to the first file
+	// found in the standard locations.
+	CloudsFile       string
+	SecureFile       string
+	PublicCloudsFile string
+}
+
+// GetCloud reads the configuration of a cloud from clouds.yaml, merged with
+// its profile from clouds-public.yaml and its secrets from secure.yaml. A nil
+// opts selects the cloud named by OS_CLOUD.
+//
+// If no cloud is named, neither by opts nor by OS_CLOUD, the configuration is
+// read from the other OS_* environment variables with CloudFromEnv.
+func GetCloud(opts *ClientOpts) (*Cloud, error) {
+	if opts == nil {
+		opts = new(ClientOpts)
+	}
+
+	name := opts.Cloud
+	if name == "" {
+		name = os.Getenv("OS_CLOUD")
+	}
+	if name == "" {
+		return CloudFromEnv(), nil
+	}
+
+	cloudsFile := findFile(opts.CloudsFile, "OS_CLIENT_CONFIG_FILE", "clouds")
+	if cloudsFile == "" {
+		return nil, ErrNoCloudsFile{}
+	}
+
+	clouds, err := loadClouds(cloudsFile, "clouds")
+	if err != nil {
+		return nil, err
+	}
+	settings, ok := clouds[name]
+	if !ok {
+		return nil, ErrCloudNotFound{Cloud: name}
+	}
+
+	if secureFile := findFile(opts.SecureFile, "OS_CLIENT_SECURE_FILE", "secure"); secureFile != "" {
+		secure, err := loadClouds(secureFile, "clouds")
+		if err != nil {
+			return nil, err
+		}
+		settings = merge(settings, secure[name])
+	}
+
+	if profile, ok := settings["profile"].(string); ok && profile != "" {
+		var public map[interface{}]interface{}
+		if publicFile := findFile(opts.PublicCloudsFile, "OS_CLIENT_PUBLIC_FILE", "clouds-public"); publicFile != "" {
+			publicClouds, err := loadClouds(publicFile, "public-clouds")
+			if err != nil {
+				return nil, err
+			}
+			public = publicClouds[profile]
+		}
+		if public == nil {
+			return nil, ErrProfileNotFound{Profile: profile}
+		}
+		settings = merge(public, settings)
+	}
+
+	return decodeCloud(settings)
+}
+
+// AuthOptions reads the options to authenticate against a cloud from
+// clouds.yaml. A nil opts selects the cloud named by OS_CLOUD, or the one
+// configured by the other OS_* environment variables.
+func AuthOptions(opts *ClientOpts) (*gophercloud.AuthOptions, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ao, err := cloud.AuthOptions()
+	if err != nil {
+		return nil, err
+	}
+	return &ao, nil
+}
+
+// AuthenticatedClient authenticates against a cloud configured in
+// clouds.yaml, and returns a ProviderClient ready to create service clients
+// with. The version of the Identity API is the identity_api_version of the
+// cloud, or else the most recent one the cloud supports. The ProviderClient
+// uses the TLS settings of the cloud, and its endpoint overrides take
+// precedence over the service catalog, even once it has re-authenticated. A
+// nil opts selects the cloud named by OS_CLOUD, or the one configured by the
+// other OS_* environment variables.
+func AuthenticatedClient(opts *ClientOpts) (*gophercloud.ProviderClient, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ao, err := cloud.AuthOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := cloud.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		client.HTTPClient.Transport = gophercloud.NewTransport(gophercloud.TransportOpts{
+			TLSClientConfig: tlsConfig,
+		})
+	}
+
+	switch cloud.IdentityAPIVersion {
+	case "":
+		err = openstack.Authenticate(client, ao)
+	case "2", "2.0":
+		err = openstack.AuthenticateV2(client, ao, gophercloud.EndpointOpts{})
+	case "3", "3.0":
+		err = openstack.AuthenticateV3(client, ao, gophercloud.EndpointOpts{})
+	default:
+		err = gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "identity_api_version"},
+			Value:           cloud.IdentityAPIVersion,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The overrides are also applied to the EndpointLocator of the tokens
+	// obtained on re-authentication.
+	client.WrapEndpointLocator(func(locator gophercloud.EndpointLocator) gophercloud.EndpointLocator {
+		return func(eo gophercloud.EndpointOpts) (string, error) {
+			if endpoint := cloud.EndpointOverride(eo.Type); endpoint != "" {
+				return gophercloud.NormalizeURL(endpoint), nil
+			}
+			return locator(eo)
+		}
+	})
+
+	return client, nil
+}
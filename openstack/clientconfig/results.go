@@ -0,0 +1,165 @@
+package clientconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/internal/authinfo"
+)
+
+// Cloud is the configuration of a cloud, as found in clouds.yaml.
+type Cloud struct {
+	// Profile is the name of the entry of clouds-public.yaml the settings of
+	// the cloud are based on.
+	Profile string `yaml:"profile"`
+
+	// AuthInfo holds the credentials of the cloud.
+	AuthInfo AuthInfo `yaml:"auth"`
+
+	// AuthType is the authentication method, such as "password" or "token".
+	// It defaults to "password".
+	AuthType string `yaml:"auth_type"`
+
+	// RegionName is the region the endpoints of services are chosen from.
+	RegionName string `yaml:"region_name"`
+
+	// Interface is the interface of the endpoints of services: "public",
+	// "internal" or "admin". EndpointType is its legacy name.
+	Interface    string `yaml:"interface"`
+	EndpointType string `yaml:"endpoint_type"`
+
+	// IdentityAPIVersion is the version of the Identity API to use.
+	IdentityAPIVersion string `yaml:"identity_api_version"`
+
+	// Verify tells whether the certificates of the cloud are verified. It
+	// defaults to true.
+	Verify *bool `yaml:"verify"`
+
+	// CACertFile is the path to a bundle of CA certificates to verify the
+	// certificates of the cloud against.
+	CACertFile string `yaml:"cacert"`
+
+	// ClientCertFile and ClientKeyFile are the paths to a certificate, and its
+	// key, identifying the client to the cloud.
+	ClientCertFile string `yaml:"cert"`
+	ClientKeyFile  string `yaml:"key"`
+
+	// Options holds the other settings of the cloud, such as the endpoint
+	// overrides of services.
+	Options map[string]interface{} `yaml:",inline"`
+}
+
+// AuthInfo holds the credentials of a cloud. Its fields are those of the auth
+// section of clouds.yaml, such as auth_url, username and project_name.
+type AuthInfo authinfo.AuthInfo
+
+// AuthOptions returns the options to authenticate against the cloud with.
+func (c *Cloud) AuthOptions() (gophercloud.AuthOptions, error) {
+	ao, err := authinfo.AuthInfo(c.AuthInfo).AuthOptions(c.AuthType)
+	if _, ok := err.(authinfo.ErrUnsupportedAuthType); ok {
+		err = ErrUnsupportedAuthType{AuthType: c.AuthType}
+	}
+	if err != nil {
+		return gophercloud.AuthOptions{}, err
+	}
+
+	ao.AllowReauth = true
+	return ao, nil
+}
+
+// EndpointOpts returns the options to select the endpoints of services with.
+// Set their Type, or pass them to a service client factory function such as
+// openstack.NewComputeV2, which sets it.
+func (c *Cloud) EndpointOpts() gophercloud.EndpointOpts {
+	eo := gophercloud.EndpointOpts{
+		Region: c.RegionName,
+	}
+
+	iface := strings.TrimSuffix(strings.ToLower(firstOf(c.Interface, c.EndpointType)), "url")
+	switch iface {
+	case "internal":
+		eo.Availability = gophercloud.AvailabilityInternal
+	case "admin":
+		eo.Availability = gophercloud.AvailabilityAdmin
+	case "public":
+		eo.Availability = gophercloud.AvailabilityPublic
+	}
+
+	return eo
+}
+
+// serviceAliases are the names used in clouds.yaml for the service types the
+// openstack package uses.
+var serviceAliases = map[string]string{
+	"volume":   "block_storage",
+	"volumev2": "block_storage",
+	"volumev3": "block_storage",
+}
+
+// EndpointOverride returns the endpoint set for a service type, such as
+// "compute", with the <service type>_endpoint_override setting. It returns an
+// empty string if the endpoint of the service isn't overridden.
+func (c *Cloud) EndpointOverride(serviceType string) string {
+	keys := []string{strings.Replace(serviceType, "-", "_", -1)}
+	if alias, ok := serviceAliases[serviceType]; ok {
+		keys = append(keys, alias)
+	}
+
+	for _, key := range keys {
+		if v, ok := c.Options[key+"_endpoint_override"].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// TLSConfig returns the TLS configuration to connect to the cloud with, or nil
+// if the cloud doesn't need one.
+func (c *Cloud) TLSConfig() (*tls.Config, error) {
+	insecure := c.Verify != nil && !*c.Verify
+	if !insecure && c.CACertFile == "" && c.ClientCertFile == "" {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		InsecureSkipVerify: insecure,
+	}
+
+	if c.CACertFile != "" {
+		pem, err := ioutil.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificate could be read from %s", c.CACertFile)
+		}
+	}
+
+	if c.ClientCertFile != "" {
+		keyFile := c.ClientKeyFile
+		if keyFile == "" {
+			keyFile = c.ClientCertFile
+		}
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/clientconfig"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	for k, v := range env {
+		th.AssertNoErr(t, os.Setenv(k, v))
+	}
+	return func() {
+		for k := range env {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+func TestCloudFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":             "https://identity.example.com/v3",
+		"OS_USERNAME":             "me",
+		"OS_PASSWORD":             "swordfish",
+		"OS_PROJECT_NAME":         "myproject",
+		"OS_TENANT_NAME":          "legacyproject",
+		"OS_USER_DOMAIN_NAME":     "Default",
+		"OS_PROJECT_DOMAIN_ID":    "default",
+		"OS_REGION_NAME":          "RegionOne",
+		"OS_INTERFACE":            "admin",
+		"OS_IDENTITY_API_VERSION": "3",
+		"OS_INSECURE":             "true",
+	})()
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "default", cloud.AuthInfo.ProjectDomainID)
+	th.AssertEquals(t, "3", cloud.IdentityAPIVersion)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "me",
+		Password:         "swordfish",
+		DomainName:       "Default",
+		TenantName:       "myproject",
+		ProjectDomainID:  "default",
+		AllowReauth:      true,
+	}, ao)
+
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "RegionOne",
+		Availability: gophercloud.AvailabilityAdmin,
+	}, cloud.EndpointOpts())
+
+	tlsConfig, err := cloud.TLSConfig()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, tlsConfig.InsecureSkipVerify)
+}
+
+func TestCloudFromEnvToken(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":      "https://identity.example.com/v3",
+		"OS_TOKEN":         "abcdef",
+		"OS_PROJECT_ID":    "1234",
+		"OS_ENDPOINT_TYPE": "internalURL",
+	})()
+
+	cloud := clientconfig.CloudFromEnv()
+	th.AssertEquals(t, "token", cloud.AuthType)
+	th.AssertEquals(t, gophercloud.AvailabilityInternal, cloud.EndpointOpts().Availability)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		TokenID:          "abcdef",
+		TenantID:         "1234",
+		AllowReauth:      true,
+	}, ao)
+}
+
+func TestCloudFromEnvApplicationCredential(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":                      "https://identity.example.com/v3",
+		"OS_APPLICATION_CREDENTIAL_NAME":   "ci",
+		"OS_APPLICATION_CREDENTIAL_SECRET": "swordfish",
+		"OS_USERNAME":                      "me",
+		"OS_USER_DOMAIN_NAME":              "Default",
+		"OS_PROJECT_NAME":                  "ignored",
+	})()
+
+	cloud := clientconfig.CloudFromEnv()
+	th.AssertEquals(t, "v3applicationcredential", cloud.AuthType)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://identity.example.com/v3",
+		Username:                    "me",
+		DomainName:                  "Default",
+		ApplicationCredentialName:   "ci",
+		ApplicationCredentialSecret: "swordfish",
+		AllowReauth:                 true,
+	}, ao)
+}
+
+func TestCloudFromEnvDomainScope(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":         "https://identity.example.com/v3",
+		"OS_USERNAME":         "admin",
+		"OS_PASSWORD":         "swordfish",
+		"OS_USER_DOMAIN_NAME": "Default",
+		"OS_DOMAIN_NAME":      "engineering",
+	})()
+
+	ao, err := clientconfig.CloudFromEnv().AuthOptions()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "admin",
+		Password:         "swordfish",
+		DomainName:       "Default",
+		Scope:            &gophercloud.AuthScope{DomainName: "engineering"},
+		AllowReauth:      true,
+	}, ao)
+}
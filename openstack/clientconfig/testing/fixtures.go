@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// CloudsYAML is a sample clouds.yaml file.
+const CloudsYAML = `
+clouds:
+  mycloud:
+    profile: rackspace
+    auth:
+      auth_url: https://identity.example.com/v3
+      username: me
+      project_name: myproject
+      user_domain_name: Default
+    region_name: RegionOne
+    interface: internal
+    verify: false
+    compute_endpoint_override: https://compute.example.com/v2.1
+    block_storage_endpoint_override: https://volume.example.com/v3
+  tokencloud:
+    auth_type: token
+    auth:
+      auth_url: https://identity.example.com/v3
+      token: abcdef
+      project_id: 1234
+`
+
+// SecureYAML is a sample secure.yaml file.
+const SecureYAML = `
+clouds:
+  mycloud:
+    auth:
+      password: swordfish
+`
+
+// PublicCloudsYAML is a sample clouds-public.yaml file.
+const PublicCloudsYAML = `
+public-clouds:
+  rackspace:
+    auth:
+      auth_url: https://identity.api.rackspacecloud.com/v2.0/
+    region_name: DFW
+    identity_api_version: "3"
+`
+
+// WriteConfigFiles writes the sample configuration files to a new directory,
+// and returns it.
+func WriteConfigFiles(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+
+	for name, content := range map[string]string{
+		"clouds.yaml":        CloudsYAML,
+		"secure.yaml":        SecureYAML,
+		"clouds-public.yaml": PublicCloudsYAML,
+	} {
+		th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	return dir
+}
+
+// RemoveConfigFiles removes the directory written by WriteConfigFiles.
+func RemoveConfigFiles(dir string) {
+	os.RemoveAll(dir)
+}
@@ -0,0 +1,178 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/clientconfig"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func clientOpts(dir, cloud string) *clientconfig.ClientOpts {
+	return &clientconfig.ClientOpts{
+		Cloud:            cloud,
+		CloudsFile:       filepath.Join(dir, "clouds.yaml"),
+		SecureFile:       filepath.Join(dir, "secure.yaml"),
+		PublicCloudsFile: filepath.Join(dir, "clouds-public.yaml"),
+	}
+}
+
+func TestGetCloud(t *testing.T) {
+	dir := WriteConfigFiles(t)
+	defer RemoveConfigFiles(dir)
+
+	cloud, err := clientconfig.GetCloud(clientOpts(dir, "mycloud"))
+	th.AssertNoErr(t, err)
+
+	// clouds.yaml overrides the profile.
+	th.AssertEquals(t, "https://identity.example.com/v3", cloud.AuthInfo.AuthURL)
+	th.AssertEquals(t, "RegionOne", cloud.RegionName)
+	// The profile provides the defaults.
+	th.AssertEquals(t, "3", cloud.IdentityAPIVersion)
+	// secure.yaml is merged in.
+	th.AssertEquals(t, "swordfish", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "me", cloud.AuthInfo.Username)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "me",
+		Password:         "swordfish",
+		DomainName:       "Default",
+		TenantName:       "myproject",
+		AllowReauth:      true,
+	}, ao)
+
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "RegionOne",
+		Availability: gophercloud.AvailabilityInternal,
+	}, cloud.EndpointOpts())
+
+	th.AssertEquals(t, "https://compute.example.com/v2.1", cloud.EndpointOverride("compute"))
+	th.AssertEquals(t, "https://volume.example.com/v3", cloud.EndpointOverride("volumev2"))
+	th.AssertEquals(t, "", cloud.EndpointOverride("network"))
+
+	tlsConfig, err := cloud.TLSConfig()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, tlsConfig.InsecureSkipVerify)
+}
+
+func TestGetCloudFromEnv(t *testing.T) {
+	dir := WriteConfigFiles(t)
+	defer RemoveConfigFiles(dir)
+
+	os.Setenv("OS_CLOUD", "tokencloud")
+	defer os.Unsetenv("OS_CLOUD")
+
+	ao, err := clientconfig.AuthOptions(clientOpts(dir, ""))
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		TokenID:          "abcdef",
+		TenantID:         "1234",
+		AllowReauth:      true,
+	}, *ao)
+}
+
+func TestGetCloudNotFound(t *testing.T) {
+	dir := WriteConfigFiles(t)
+	defer RemoveConfigFiles(dir)
+
+	_, err := clientconfig.GetCloud(clientOpts(dir, "othercloud"))
+	if _, ok := err.(clientconfig.ErrCloudNotFound); !ok {
+		t.Fatalf("Expected an ErrCloudNotFound, got %#v", err)
+	}
+}
+
+func TestAuthenticatedClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The version of the Identity API is not discovered, as the cloud sets it.
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %s", r.URL)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+			{
+				"token": {
+					"expires_at": "2013-02-02T18:30:59.000000Z",
+					"catalog": [
+						{
+							"type": "network",
+							"endpoints": [
+								{ "interface": "public", "region": "RegionOne", "url": "https://network.example.com/" }
+							]
+						}
+					]
+				}
+			}
+		`)
+	})
+
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "clouds.yaml"), []byte(fmt.Sprintf(`
+clouds:
+  local:
+    auth:
+      auth_url: %s
+      username: me
+      password: swordfish
+      user_domain_name: Default
+    identity_api_version: "3"
+    compute_endpoint_override: https://compute.example.com/v2.1
+`, th.Endpoint())), 0600))
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "secure.yaml"), []byte("clouds: {}\n"), 0600))
+
+	client, err := clientconfig.AuthenticatedClient(clientOpts(dir, "local"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0123456789", client.Token())
+
+	checkEndpoints := func() {
+		url, err := client.LocateEndpoint(gophercloud.EndpointOpts{Type: "compute", Availability: gophercloud.AvailabilityPublic})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "https://compute.example.com/v2.1/", url)
+		url, err = client.LocateEndpoint(gophercloud.EndpointOpts{Type: "network", Availability: gophercloud.AvailabilityPublic})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "https://network.example.com/", url)
+	}
+	checkEndpoints()
+
+	// The endpoint overrides still apply once the client has re-authenticated.
+	th.AssertNoErr(t, client.ReauthFunc(context.Background()))
+	checkEndpoints()
+}
+
+func TestAuthenticatedClientInvalidIdentityAPIVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "clouds.yaml"), []byte(`
+clouds:
+  local:
+    auth:
+      auth_url: https://identity.example.com/
+      username: me
+      password: swordfish
+    identity_api_version: "4"
+`), 0600))
+	th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, "secure.yaml"), []byte("clouds: {}\n"), 0600))
+
+	_, err = clientconfig.AuthenticatedClient(clientOpts(dir, "local"))
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected an ErrInvalidInput, got %#v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package clientconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// configDirs returns the directories clouds.yaml and its companion files are
+// looked up in, by decreasing precedence.
+func configDirs() []string {
+	dirs := []string{"."}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		dirs = append(dirs, filepath.Join(xdg, "openstack"))
+	} else if home := os.Getenv("HOME"); home != "" {
+		dirs = append(dirs, filepath.Join(home, ".config", "openstack"))
+	}
+
+	return append(dirs, "/etc/openstack")
+}
+
+// findFile returns the path to a configuration file: path if it's set, or the
+// value of the environment variable env if it's set, or the first file named
+// name.yaml or name.yml in the configuration directories. It returns an empty
+// string if no file is found.
+func findFile(path, env, name string) string {
+	if path != "" {
+		return path
+	}
+	if path := os.Getenv(env); path != "" {
+		return path
+	}
+
+	for _, dir := range configDirs() {
+		for _, ext := range []string{".yaml", ".yml"} {
+			path := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// loadClouds reads the clouds defined under the key top of a configuration
+// file.
+func loadClouds(path, top string) (map[string]map[interface{}]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file map[string]map[string]map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, err
+	}
+	return file[top], nil
+}
+
+// merge returns the settings of base, overridden by those of override. Nested
+// settings, such as auth, are merged recursively.
+func merge(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := make(map[interface{}]interface{}, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+
+	for k, v := range override {
+		baseMap, baseIsMap := merged[k].(map[interface{}]interface{})
+		overrideMap, overrideIsMap := v.(map[interface{}]interface{})
+		if baseIsMap && overrideIsMap {
+			merged[k] = merge(baseMap, overrideMap)
+			continue
+		}
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// decodeCloud turns merged settings into a Cloud.
+func decodeCloud(settings map[interface{}]interface{}) (*Cloud, error) {
+	b, err := yaml.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var cloud Cloud
+	if err := yaml.Unmarshal(b, &cloud); err != nil {
+		return nil, err
+	}
+	return &cloud, nil
+}
@@ -65,6 +65,8 @@ func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 
 // Delete will permanently delete a default rule from the project.
 func Delete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	_, r.Err = client.Delete(resourceURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -1,12 +1,33 @@
 package diskconfig
 
-import "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
 
 type ServerWithDiskConfig struct {
 	servers.Server
 	DiskConfig DiskConfig `json:"OS-DCF:diskConfig"`
 }
 
+// UnmarshalJSON decodes the Server and the DiskConfig separately, since the
+// Server's own UnmarshalJSON would otherwise ignore the DiskConfig.
+func (s *ServerWithDiskConfig) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &s.Server); err != nil {
+		return err
+	}
+
+	var dc struct {
+		DiskConfig DiskConfig `json:"OS-DCF:diskConfig"`
+	}
+	if err := json.Unmarshal(b, &dc); err != nil {
+		return err
+	}
+	s.DiskConfig = dc.DiskConfig
+	return nil
+}
+
 func (s ServerWithDiskConfig) ToServerCreateResult() (m map[string]interface{}) {
 	m["OS-DCF:diskConfig"] = s.DiskConfig
 	return
@@ -1,7 +1,9 @@
 package testing
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/diskconfig"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
@@ -86,3 +88,21 @@ func TestResizeOpts(t *testing.T) {
 	`
 	th.CheckJSONEquals(t, expected, actual)
 }
+
+func TestServerWithDiskConfig(t *testing.T) {
+	var s diskconfig.ServerWithDiskConfig
+	err := json.Unmarshal([]byte(`
+		{
+			"id": "1234asdf",
+			"status": "ACTIVE",
+			"created": "2014-09-25T13:10:02Z",
+			"OS-DCF:diskConfig": "MANUAL"
+		}
+	`), &s)
+	th.AssertNoErr(t, err)
+
+	// Both the fields of the Server and the one of the extension are decoded.
+	th.AssertEquals(t, "1234asdf", s.ID)
+	th.AssertEquals(t, time.Date(2014, 9, 25, 13, 10, 2, 0, time.UTC), s.Created)
+	th.AssertEquals(t, diskconfig.Manual, s.DiskConfig)
+}
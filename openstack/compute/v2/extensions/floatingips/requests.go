@@ -50,7 +50,9 @@ func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 
 // Delete requests the deletion of a previous allocated FloatingIP.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	_, r.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -80,7 +82,9 @@ func AssociateInstance(client *gophercloud.ServiceClient, serverID string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(associateURL(client, serverID), b, nil, nil)
+	_, r.Err = client.Post(associateURL(client, serverID), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -107,6 +111,8 @@ func DisassociateInstance(client *gophercloud.ServiceClient, serverID string, op
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(disassociateURL(client, serverID), b, nil, nil)
+	_, r.Err = client.Post(disassociateURL(client, serverID), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -79,6 +79,8 @@ func Get(client *gophercloud.ServiceClient, name string) (r GetResult) {
 
 // Delete requests the deletion of a previous stored KeyPair from the server.
 func Delete(client *gophercloud.ServiceClient, name string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, name), nil)
+	_, r.Err = client.Delete(deleteURL(client, name), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -1,6 +1,9 @@
 package networks
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -23,13 +26,13 @@ type Network struct {
 	CIDRv6 string `json:"cidr_v6"`
 
 	// CreatedAt is when the network was created..
-	CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
 
 	// Deleted shows if the network has been deleted.
 	Deleted bool `json:"deleted"`
 
 	// DeletedAt is the time when the network was deleted.
-	DeletedAt gophercloud.JSONRFC3339MilliNoZ `json:"deleted_at,omitempty"`
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 
 	// DHCPStart is the start of the DHCP address range.
 	DHCPStart string `json:"dhcp_start"`
@@ -77,7 +80,7 @@ type Network struct {
 	RXTXBase int `json:"rxtx_base"`
 
 	// UpdatedAt is the time when the network was last updated.
-	UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 
 	// VLAN is the vlan this network runs on.
 	VLAN int `json:"vlan"`
@@ -92,6 +95,27 @@ type Network struct {
 	VPNPublicPort int `json:"vpn_public_port"`
 }
 
+// UnmarshalJSON decodes a Network, parsing its timestamps with
+// gophercloud.JSONISO8601.
+func (r *Network) UnmarshalJSON(b []byte) error {
+	type tmp Network
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONISO8601 `json:"created_at,omitempty"`
+		DeletedAt gophercloud.JSONISO8601 `json:"deleted_at,omitempty"`
+		UpdatedAt gophercloud.JSONISO8601 `json:"updated_at,omitempty"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Network(s.tmp)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.DeletedAt = time.Time(s.DeletedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+	return nil
+}
+
 // NetworkPage stores a single, only page of Networks
 // results from a List call.
 type NetworkPage struct {
@@ -52,6 +52,8 @@ func Get(client *gophercloud.ServiceClient, serverID, attachmentID string) (r Ge
 
 // Delete requests the deletion of a previous stored VolumeAttachment from the server.
 func Delete(client *gophercloud.ServiceClient, serverID, attachmentID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, serverID, attachmentID), nil)
+	_, r.Err = client.Delete(deleteURL(client, serverID, attachmentID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
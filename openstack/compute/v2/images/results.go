@@ -1,6 +1,9 @@
 package images
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -30,7 +33,9 @@ type Image struct {
 	// ID contains the image's unique identifier.
 	ID string
 
-	Created string
+	// Created and Updated are the times the image was created and last
+	// updated.
+	Created time.Time
 
 	// MinDisk and MinRAM specify the minimum resources a server must provide to be able to install the image.
 	MinDisk int
@@ -44,11 +49,30 @@ type Image struct {
 	Progress int
 	Status   string
 
-	Updated string
-	
+	Updated time.Time
+
 	Metadata map[string]string
 }
 
+// UnmarshalJSON decodes an Image, parsing Created and Updated with
+// gophercloud.JSONISO8601.
+func (r *Image) UnmarshalJSON(b []byte) error {
+	type tmp Image
+	var s struct {
+		tmp
+		Created gophercloud.JSONISO8601 `json:"created"`
+		Updated gophercloud.JSONISO8601 `json:"updated"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Image(s.tmp)
+	r.Created = time.Time(s.Created)
+	r.Updated = time.Time(s.Updated)
+	return nil
+}
+
 // ImagePage contains a single page of results from a List operation.
 // Use ExtractImages to convert it into a slice of usable structs.
 type ImagePage struct {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -75,8 +76,8 @@ func TestListImages(t *testing.T) {
 			{
 				ID:       "f3e4a95d-1f4f-4989-97ce-f3a1fb8c04d7",
 				Name:     "F17-x86_64-cfntools",
-				Created:  "2014-09-23T12:54:52Z",
-				Updated:  "2014-09-23T12:54:56Z",
+				Created:  time.Date(2014, 9, 23, 12, 54, 52, 0, time.UTC),
+				Updated:  time.Date(2014, 9, 23, 12, 54, 56, 0, time.UTC),
 				MinDisk:  0,
 				MinRAM:   0,
 				Progress: 100,
@@ -85,8 +86,8 @@ func TestListImages(t *testing.T) {
 			{
 				ID:       "f90f6034-2570-4974-8351-6b49732ef2eb",
 				Name:     "cirros-0.3.2-x86_64-disk",
-				Created:  "2014-09-23T12:51:42Z",
-				Updated:  "2014-09-23T12:51:43Z",
+				Created:  time.Date(2014, 9, 23, 12, 51, 42, 0, time.UTC),
+				Updated:  time.Date(2014, 9, 23, 12, 51, 43, 0, time.UTC),
 				MinDisk:  0,
 				MinRAM:   0,
 				Progress: 100,
@@ -142,10 +143,10 @@ func TestGetImage(t *testing.T) {
 
 	expected := &images.Image{
 		Status:   "ACTIVE",
-		Updated:  "2014-09-23T12:54:56Z",
+		Updated:  time.Date(2014, 9, 23, 12, 54, 56, 0, time.UTC),
 		ID:       "f3e4a95d-1f4f-4989-97ce-f3a1fb8c04d7",
 		Name:     "F17-x86_64-cfntools",
-		Created:  "2014-09-23T12:54:52Z",
+		Created:  time.Date(2014, 9, 23, 12, 54, 52, 0, time.UTC),
 		MinDisk:  0,
 		Progress: 100,
 		MinRAM:   0,
@@ -271,13 +271,17 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 
 // Delete requests that a server previously provisioned be removed from your account.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	_, r.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
 // ForceDelete forces the deletion of a server
 func ForceDelete(client *gophercloud.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"forceDelete": ""}, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"forceDelete": ""}, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -333,7 +337,9 @@ func ChangeAdminPassword(client *gophercloud.ServiceClient, id, newPassword stri
 			"adminPass": newPassword,
 		},
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -382,7 +388,9 @@ func Reboot(client *gophercloud.ServiceClient, id string, opts RebootOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -487,7 +495,9 @@ func Resize(client *gophercloud.ServiceClient, id string, opts ResizeOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -495,7 +505,8 @@ func Resize(client *gophercloud.ServiceClient, id string, opts ResizeOptsBuilder
 // See Resize() for more details.
 func ConfirmResize(client *gophercloud.ServiceClient, id string) (r ActionResult) {
 	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"confirmResize": nil}, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{201, 202, 204},
+		OkCodes:           []int{201, 202, 204},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -503,7 +514,9 @@ func ConfirmResize(client *gophercloud.ServiceClient, id string) (r ActionResult
 // RevertResize cancels a previous rize operation on a server.
 // See Resize() for more details.
 func RevertResize(client *gophercloud.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"revertResize": nil}, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"revertResize": nil}, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -648,7 +661,9 @@ func Metadatum(client *gophercloud.ServiceClient, id, key string) (r GetMetadatu
 
 // DeleteMetadatum will delete the key-value pair with the given key for the given server ID.
 func DeleteMetadatum(client *gophercloud.ServiceClient, id, key string) (r DeleteMetadatumResult) {
-	_, r.Err = client.Delete(metadatumURL(client, id, key), nil)
+	_, r.Err = client.Delete(metadatumURL(client, id, key), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -694,7 +709,8 @@ func CreateImage(client *gophercloud.ServiceClient, id string, opts CreateImageO
 		return
 	}
 	resp, err := client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
-		OkCodes: []int{202},
+		OkCodes:           []int{202},
+		DrainResponseBody: true,
 	})
 	r.Err = err
 	r.Header = resp.Header
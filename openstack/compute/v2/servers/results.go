@@ -3,9 +3,11 @@ package servers
 import (
 	"crypto/rsa"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -146,9 +148,9 @@ type Server struct {
 	// Name contains the human-readable name for the server.
 	Name string `json:"name"`
 
-	// Updated and Created contain ISO-8601 timestamps of when the state of the server last changed, and when it was created.
-	Updated string
-	Created string
+	// Updated and Created contain the times when the state of the server last changed, and when it was created.
+	Updated time.Time `json:"updated"`
+	Created time.Time `json:"created"`
 
 	HostID string
 
@@ -162,14 +164,14 @@ type Server struct {
 	// AccessIPv4 and AccessIPv6 contain the IP addresses of the server, suitable for remote access for administration.
 	AccessIPv4, AccessIPv6 string
 
-	// Image refers to a JSON object, which itself indicates the OS image used to deploy the server.
-	Image map[string]interface{}
+	// Image refers to the OS image used to deploy the server. It is empty for a server booted from a volume.
+	Image ImageRef `json:"image"`
 
-	// Flavor refers to a JSON object, which itself indicates the hardware configuration of the deployed server.
-	Flavor map[string]interface{}
+	// Flavor refers to the hardware configuration of the deployed server.
+	Flavor FlavorRef `json:"flavor"`
 
 	// Addresses includes a list of all IP addresses assigned to the server, keyed by pool.
-	Addresses map[string]interface{}
+	Addresses map[string][]Address `json:"addresses"`
 
 	// Metadata includes a list of all user-specified key-value pairs attached to the server.
 	Metadata map[string]interface{}
@@ -186,6 +188,76 @@ type Server struct {
 
 	// SecurityGroups includes the security groups that this instance has applied to it
 	SecurityGroups []map[string]interface{} `json:"security_groups"`
+
+	// Fault describes the failure of a server in the ERROR status.
+	Fault Fault `json:"fault"`
+}
+
+// UnmarshalJSON decodes a Server, parsing Created and Updated with
+// gophercloud.JSONISO8601. A struct embedding Server inherits this method, and
+// would otherwise only get the Server's fields decoded, so it must decode its
+// own fields separately, as diskconfig.ServerWithDiskConfig does.
+func (r *Server) UnmarshalJSON(b []byte) error {
+	type tmp Server
+	var s struct {
+		tmp
+		Updated gophercloud.JSONISO8601 `json:"updated"`
+		Created gophercloud.JSONISO8601 `json:"created"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Server(s.tmp)
+	r.Updated = time.Time(s.Updated)
+	r.Created = time.Time(s.Created)
+	return nil
+}
+
+// Fault describes the failure of a server.
+type Fault struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details"`
+}
+
+// ImageRef refers to the image a server was deployed from.
+type ImageRef struct {
+	ID    string             `json:"id"`
+	Links []gophercloud.Link `json:"links"`
+}
+
+// UnmarshalJSON accepts the empty string Nova returns in place of the image
+// of a server booted from a volume.
+func (r *ImageRef) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*r = ImageRef{}
+		return nil
+	}
+
+	type tmp ImageRef
+	var ref tmp
+	if err := json.Unmarshal(b, &ref); err != nil {
+		return err
+	}
+	*r = ImageRef(ref)
+	return nil
+}
+
+// FlavorRef refers to the flavor of a server. Starting with microversion 2.47,
+// it describes the flavor instead of holding its ID and links.
+type FlavorRef struct {
+	ID    string             `json:"id"`
+	Links []gophercloud.Link `json:"links"`
+
+	OriginalName string            `json:"original_name"`
+	VCPUs        int               `json:"vcpus"`
+	RAM          int               `json:"ram"`
+	Disk         int               `json:"disk"`
+	Ephemeral    int               `json:"ephemeral"`
+	Swap         int               `json:"swap"`
+	ExtraSpecs   map[string]string `json:"extra_specs"`
 }
 
 // ServerPage abstracts the raw results of making a List() request against the API.
@@ -284,6 +356,11 @@ func (r MetadatumResult) Extract() (map[string]string, error) {
 type Address struct {
 	Version int    `json:"version"`
 	Address string `json:"addr"`
+
+	// MACAddr and Type are only reported when the server is retrieved, by the
+	// extended IPs extensions.
+	MACAddr string `json:"OS-EXT-IPS-MAC:mac_addr,omitempty"`
+	Type    string `json:"OS-EXT-IPS:type,omitempty"`
 }
 
 // AddressPage abstracts the raw results of making a ListAddresses() request against the API.
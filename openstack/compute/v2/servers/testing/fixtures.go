@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
@@ -245,15 +246,15 @@ var (
 	// ServerHerp is a Server struct that should correspond to the first result in ServerListBody.
 	ServerHerp = servers.Server{
 		Status:  "ACTIVE",
-		Updated: "2014-09-25T13:10:10Z",
+		Updated: time.Date(2014, 9, 25, 13, 10, 10, 0, time.UTC),
 		HostID:  "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
-		Addresses: map[string]interface{}{
-			"private": []interface{}{
-				map[string]interface{}{
-					"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b",
-					"version":                 float64(4),
-					"addr":                    "10.0.0.32",
-					"OS-EXT-IPS:type":         "fixed",
+		Addresses: map[string][]servers.Address{
+			"private": {
+				{
+					MACAddr: "fa:16:3e:7c:1b:2b",
+					Version: 4,
+					Address: "10.0.0.32",
+					Type:    "fixed",
 				},
 			},
 		},
@@ -267,28 +268,28 @@ var (
 				"rel":  "bookmark",
 			},
 		},
-		Image: map[string]interface{}{
-			"id": "f90f6034-2570-4974-8351-6b49732ef2eb",
-			"links": []interface{}{
-				map[string]interface{}{
-					"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/images/f90f6034-2570-4974-8351-6b49732ef2eb",
-					"rel":  "bookmark",
+		Image: servers.ImageRef{
+			ID: "f90f6034-2570-4974-8351-6b49732ef2eb",
+			Links: []gophercloud.Link{
+				{
+					Href: "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/images/f90f6034-2570-4974-8351-6b49732ef2eb",
+					Rel:  "bookmark",
 				},
 			},
 		},
-		Flavor: map[string]interface{}{
-			"id": "1",
-			"links": []interface{}{
-				map[string]interface{}{
-					"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/flavors/1",
-					"rel":  "bookmark",
+		Flavor: servers.FlavorRef{
+			ID: "1",
+			Links: []gophercloud.Link{
+				{
+					Href: "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/flavors/1",
+					Rel:  "bookmark",
 				},
 			},
 		},
 		ID:       "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
 		UserID:   "9349aff8be7545ac9d2f1d00999a23cd",
 		Name:     "herp",
-		Created:  "2014-09-25T13:10:02Z",
+		Created:  time.Date(2014, 9, 25, 13, 10, 2, 0, time.UTC),
 		TenantID: "fcad67a6189847c4aecfa3c81a05783b",
 		Metadata: map[string]interface{}{},
 		SecurityGroups: []map[string]interface{}{
@@ -301,15 +302,15 @@ var (
 	// ServerDerp is a Server struct that should correspond to the second server in ServerListBody.
 	ServerDerp = servers.Server{
 		Status:  "ACTIVE",
-		Updated: "2014-09-25T13:04:49Z",
+		Updated: time.Date(2014, 9, 25, 13, 4, 49, 0, time.UTC),
 		HostID:  "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
-		Addresses: map[string]interface{}{
-			"private": []interface{}{
-				map[string]interface{}{
-					"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:9e:89:be",
-					"version":                 float64(4),
-					"addr":                    "10.0.0.31",
-					"OS-EXT-IPS:type":         "fixed",
+		Addresses: map[string][]servers.Address{
+			"private": {
+				{
+					MACAddr: "fa:16:3e:9e:89:be",
+					Version: 4,
+					Address: "10.0.0.31",
+					Type:    "fixed",
 				},
 			},
 		},
@@ -323,28 +324,28 @@ var (
 				"rel":  "bookmark",
 			},
 		},
-		Image: map[string]interface{}{
-			"id": "f90f6034-2570-4974-8351-6b49732ef2eb",
-			"links": []interface{}{
-				map[string]interface{}{
-					"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/images/f90f6034-2570-4974-8351-6b49732ef2eb",
-					"rel":  "bookmark",
+		Image: servers.ImageRef{
+			ID: "f90f6034-2570-4974-8351-6b49732ef2eb",
+			Links: []gophercloud.Link{
+				{
+					Href: "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/images/f90f6034-2570-4974-8351-6b49732ef2eb",
+					Rel:  "bookmark",
 				},
 			},
 		},
-		Flavor: map[string]interface{}{
-			"id": "1",
-			"links": []interface{}{
-				map[string]interface{}{
-					"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/flavors/1",
-					"rel":  "bookmark",
+		Flavor: servers.FlavorRef{
+			ID: "1",
+			Links: []gophercloud.Link{
+				{
+					Href: "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/flavors/1",
+					Rel:  "bookmark",
 				},
 			},
 		},
 		ID:       "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
 		UserID:   "9349aff8be7545ac9d2f1d00999a23cd",
 		Name:     "derp",
-		Created:  "2014-09-25T13:04:41Z",
+		Created:  time.Date(2014, 9, 25, 13, 4, 41, 0, time.UTC),
 		TenantID: "fcad67a6189847c4aecfa3c81a05783b",
 		Metadata: map[string]interface{}{},
 		SecurityGroups: []map[string]interface{}{
@@ -458,6 +459,52 @@ func HandleServerGetSuccessfully(t *testing.T) {
 	})
 }
 
+// ErrorServerBody is the canned body of a Get request on a server which
+// failed to build.
+const ErrorServerBody = `
+{
+	"server": {
+		"id": "1234asdf",
+		"name": "derp",
+		"status": "ERROR",
+		"fault": {
+			"code": 500,
+			"created": "2014-09-25T13:10:02Z",
+			"message": "No valid host was found.",
+			"details": ""
+		}
+	}
+}
+`
+
+// HandleServerGetInStatuses sets up the test server to respond to successive
+// server Get requests with a server in each of the given statuses, then in
+// the last one. A status of "HANG" holds the response until the request is
+// cancelled.
+func HandleServerGetInStatuses(t *testing.T, statuses ...string) {
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+
+		if status == "HANG" {
+			<-r.Context().Done()
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		if status == "ERROR" {
+			fmt.Fprint(w, ErrorServerBody)
+			return
+		}
+		fmt.Fprintf(w, `{"server": {"id": "1234asdf", "name": "derp", "status": "%s"}}`, status)
+	})
+}
+
 // HandleServerUpdateSuccessfully sets up the test server to respond to a server Update request.
 func HandleServerUpdateSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -410,3 +413,80 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetInStatuses(t, "BUILD", "BUILD", "ACTIVE")
+
+	server, err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ACTIVE", server.Status)
+}
+
+func TestWaitForStatusFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetInStatuses(t, "BUILD", "ERROR")
+
+	server, err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+	})
+
+	failed, ok := err.(gophercloud.ErrResourceFailed)
+	if !ok {
+		t.Fatalf("Expected an ErrResourceFailed, got %#v", err)
+	}
+	th.AssertEquals(t, "ERROR", failed.Status)
+	th.AssertEquals(t, "No valid host was found.", failed.Detail)
+	th.AssertEquals(t, "ERROR", server.Status)
+	th.AssertEquals(t, 500, server.Fault.Code)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetInStatuses(t, "BUILD")
+
+	server, err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+		Timeout:  20 * time.Millisecond,
+	})
+
+	if _, ok := err.(gophercloud.ErrWaitTimeout); !ok {
+		t.Fatalf("Expected an ErrWaitTimeout, got %#v", err)
+	}
+	th.AssertEquals(t, "BUILD", server.Status)
+}
+
+func TestWaitForStatusTimeoutDuringGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetInStatuses(t, "BUILD", "HANG")
+
+	server, err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+		Timeout:  50 * time.Millisecond,
+	})
+
+	timeoutErr, ok := err.(gophercloud.ErrWaitTimeout)
+	if !ok {
+		t.Fatalf("Expected an ErrWaitTimeout, got %#v", err)
+	}
+	th.AssertEquals(t, context.DeadlineExceeded, timeoutErr.Err)
+	th.AssertEquals(t, "BUILD", server.Status)
+}
+
+func TestWaitForStatusNoTime(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetInStatuses(t, "ACTIVE")
+
+	// Without any time to wait, WaitForStatus times out right away.
+	err := servers.WaitForStatus(client.ServiceClient(), "1234asdf", "ACTIVE", 0)
+	if _, ok := err.(gophercloud.ErrWaitTimeout); !ok {
+		t.Fatalf("Expected an ErrWaitTimeout, got %#v", err)
+	}
+}
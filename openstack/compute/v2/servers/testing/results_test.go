@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
@@ -108,3 +109,27 @@ func TestListAddressesAllPages(t *testing.T) {
 	_, err = servers.ExtractAddresses(allPages)
 	th.AssertNoErr(t, err)
 }
+
+func TestExtractServerBootedFromVolume(t *testing.T) {
+	var dejson interface{}
+	err := json.Unmarshal([]byte(`
+	{
+		"server": {
+			"id": "1234asdf",
+			"image": "",
+			"flavor": {"original_name": "m1.small", "vcpus": 1, "ram": 2048, "disk": 20, "ephemeral": 0, "swap": 0},
+			"created": "2014-09-25T13:10:02.000000",
+			"updated": "2014-09-25T13:10:10Z"
+		}
+	}`), &dejson)
+	th.AssertNoErr(t, err)
+
+	resp := servers.GetResult{Result: gophercloud.Result{Body: dejson}}
+	server, err := resp.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, servers.ImageRef{}, server.Image)
+	th.AssertEquals(t, "m1.small", server.Flavor.OriginalName)
+	th.AssertEquals(t, 2048, server.Flavor.RAM)
+	th.AssertEquals(t, time.Date(2014, 9, 25, 13, 10, 2, 0, time.UTC), server.Created)
+	th.AssertEquals(t, time.Date(2014, 9, 25, 13, 10, 10, 0, time.UTC), server.Updated)
+}
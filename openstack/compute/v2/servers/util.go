@@ -1,20 +1,61 @@
 package servers
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"context"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// FailureStatuses are the statuses of a server which has failed. Waiting for
+// any other status stops as soon as a server reaches one of them.
+var FailureStatuses = []string{"ERROR"}
 
 // WaitForStatus will continually poll a server until it successfully transitions to a specified
 // status. It will do this for at most the number of seconds specified.
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
-		current, err := Get(c, id).Extract()
+	// As with gophercloud.WaitFor, a negative number of seconds waits forever.
+	ctx := context.Background()
+	if secs >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(secs)*time.Second)
+		defer cancel()
+	}
+	_, err := WaitForStatusContext(ctx, c, id, status, gophercloud.WaitOpts{})
+	return err
+}
+
+// WaitForStatusContext polls a server until it transitions to the specified
+// status, and returns it. If the server reaches one of FailureStatuses
+// instead, it returns a gophercloud.ErrResourceFailed holding the server's
+// fault. If ctx is done first, it returns a gophercloud.ErrWaitTimeout
+// holding the last server observed.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string, opts gophercloud.WaitOpts) (*Server, error) {
+	r, err := gophercloud.Wait(ctx, opts, func(ctx context.Context) (interface{}, bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
 		if current.Status == status {
-			return true, nil
+			return current, true, nil
 		}
 
-		return false, nil
+		for _, s := range FailureStatuses {
+			if current.Status == s {
+				return current, false, gophercloud.ErrResourceFailed{
+					ResourceType: "server",
+					ID:           id,
+					Status:       current.Status,
+					Detail:       current.Fault.Message,
+					Resource:     current,
+				}
+			}
+		}
+
+		return current, false, nil
 	})
+
+	server, _ := r.(*Server)
+	return server, err
 }
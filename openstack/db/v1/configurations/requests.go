@@ -97,7 +97,9 @@ func Update(client *gophercloud.ServiceClient, configID string, opts UpdateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(resourceURL(client, configID), &b, nil, nil)
+	_, r.Err = client.Patch(resourceURL(client, configID), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -110,7 +112,9 @@ func Replace(client *gophercloud.ServiceClient, configID string, opts UpdateOpts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, configID), &b, nil, nil)
+	_, r.Err = client.Put(resourceURL(client, configID), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -118,7 +122,9 @@ func Replace(client *gophercloud.ServiceClient, configID string, opts UpdateOpts
 // config groups cannot be deleted whilst still attached to running instances -
 // you must detach and then delete them.
 func Delete(client *gophercloud.ServiceClient, configID string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, configID), nil)
+	_, r.Err = client.Delete(resourceURL(client, configID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -68,7 +68,9 @@ func Create(client *gophercloud.ServiceClient, instanceID string, opts CreateOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(baseURL(client, instanceID), &b, nil, nil)
+	_, r.Err = client.Post(baseURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -84,6 +86,8 @@ func List(client *gophercloud.ServiceClient, instanceID string) pagination.Pager
 // Delete will permanently delete the database within a specified instance.
 // All contained data inside the database will also be permanently deleted.
 func Delete(client *gophercloud.ServiceClient, instanceID, dbName string) (r DeleteResult) {
-	_, r.Err = client.Delete(dbURL(client, instanceID, dbName), nil)
+	_, r.Err = client.Delete(dbURL(client, instanceID, dbName), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
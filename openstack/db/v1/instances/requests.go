@@ -123,7 +123,9 @@ func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 
 // Delete permanently destroys the database instance.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	_, r.Err = client.Delete(resourceURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -147,7 +149,9 @@ func IsRootEnabled(client *gophercloud.ServiceClient, id string) (r IsRootEnable
 // The MySQL service will be unavailable until the instance restarts.
 func Restart(client *gophercloud.ServiceClient, id string) (r ActionResult) {
 	b := map[string]interface{}{"restart": struct{}{}}
-	_, r.Err = client.Post(actionURL(client, id), &b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -155,7 +159,9 @@ func Restart(client *gophercloud.ServiceClient, id string) (r ActionResult) {
 // flavorRef is provided. It will also restart the MySQL service.
 func Resize(client *gophercloud.ServiceClient, id, flavorRef string) (r ActionResult) {
 	b := map[string]interface{}{"resize": map[string]string{"flavorRef": flavorRef}}
-	_, r.Err = client.Post(actionURL(client, id), &b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -164,6 +170,8 @@ func Resize(client *gophercloud.ServiceClient, id, flavorRef string) (r ActionRe
 // The volume size is in gigabytes (GB) and must be an integer.
 func ResizeVolume(client *gophercloud.ServiceClient, id string, size int) (r ActionResult) {
 	b := map[string]interface{}{"resize": map[string]interface{}{"volume": map[string]int{"size": size}}}
-	_, r.Err = client.Post(actionURL(client, id), &b, nil, nil)
+	_, r.Err = client.Post(actionURL(client, id), &b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -17,7 +17,9 @@ func List(client *gophercloud.ServiceClient) pagination.Pager {
 // a user. This is confined to the scope of the user's tenant - so the tenant
 // ID is a required argument.
 func AddUser(client *gophercloud.ServiceClient, tenantID, userID, roleID string) (r UserRoleResult) {
-	_, r.Err = client.Put(userRoleURL(client, tenantID, userID, roleID), nil, nil, nil)
+	_, r.Err = client.Put(userRoleURL(client, tenantID, userID, roleID), nil, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -25,6 +27,8 @@ func AddUser(client *gophercloud.ServiceClient, tenantID, userID, roleID string)
 // from a user. This is confined to the scope of the user's tenant - so the
 // tenant ID is a required argument.
 func DeleteUser(client *gophercloud.ServiceClient, tenantID, userID, roleID string) (r UserRoleResult) {
-	_, r.Err = client.Delete(userRoleURL(client, tenantID, userID, roleID), nil)
+	_, r.Err = client.Delete(userRoleURL(client, tenantID, userID, roleID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
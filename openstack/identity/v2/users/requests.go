@@ -94,7 +94,9 @@ func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder
 
 // Delete is the operation responsible for permanently deleting an API user.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(ResourceURL(client, id), nil)
+	_, r.Err = client.Delete(ResourceURL(client, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
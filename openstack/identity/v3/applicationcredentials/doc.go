@@ -0,0 +1,67 @@
+/*
+Package applicationcredentials provides information and interaction with the
+application credentials API resource for the OpenStack Identity service.
+
+An application credential lets an application authenticate as the user who
+created it, on the project it was created in, with a subset of the user's roles
+and, optionally, only to call specific APIs. See the
+ApplicationCredentialID, ApplicationCredentialName and
+ApplicationCredentialSecret fields of gophercloud.AuthOptions to authenticate
+with one.
+
+Example to List Application Credentials
+
+	userID := "d8e8fca2dc0f896fd7cb4cb0031ba249"
+
+	allPages, err := applicationcredentials.List(identityClient, userID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allApplicationCredentials, err := applicationcredentials.ExtractApplicationCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, applicationCredential := range allApplicationCredentials {
+		fmt.Printf("%+v\n", applicationCredential)
+	}
+
+Example to Create an Application Credential
+
+	userID := "d8e8fca2dc0f896fd7cb4cb0031ba249"
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name:        "ci",
+		Description: "Used by the CI to deploy",
+		Roles: []applicationcredentials.Role{
+			{Name: "member"},
+		},
+		AccessRules: []applicationcredentials.AccessRule{
+			{
+				Path:    "/v2.1/servers",
+				Method:  "GET",
+				Service: "compute",
+			},
+		},
+	}
+
+	applicationCredential, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The secret is only returned when the application credential is created.
+	fmt.Println(applicationCredential.Secret)
+
+Example to Delete an Application Credential
+
+	userID := "d8e8fca2dc0f896fd7cb4cb0031ba249"
+	applicationCredentialID := "c4859fb437df4b87a51a8f5adcfb0bc7"
+
+	err := applicationcredentials.Delete(identityClient, userID, applicationCredentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
@@ -0,0 +1,144 @@
+package applicationcredentials
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToApplicationCredentialListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// Name filters the response by an application credential name.
+	Name string `q:"name"`
+}
+
+// ToApplicationCredentialListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToApplicationCredentialListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the application credentials of a user.
+func List(client *gophercloud.ServiceClient, userID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, userID)
+	if opts != nil {
+		query, err := opts.ToApplicationCredentialListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ApplicationCredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single application credential of a user, by ID.
+func Get(client *gophercloud.ServiceClient, userID string, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToApplicationCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create an application credential.
+type CreateOpts struct {
+	// Name is the name of the application credential.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the application credential.
+	Description string `json:"description,omitempty"`
+
+	// Secret is the secret of the application credential. If it's not set,
+	// one is generated by the Identity service.
+	Secret string `json:"secret,omitempty"`
+
+	// Unrestricted allows the application credential to create and delete
+	// other application credentials and trusts. This is a security risk, and
+	// defaults to false.
+	Unrestricted bool `json:"unrestricted"`
+
+	// Roles restricts the application credential to a subset of the roles of
+	// the user on the project, identified by ID or by name. It defaults to all
+	// of them.
+	Roles []Role `json:"roles,omitempty"`
+
+	// AccessRules restricts the application credential to the API calls it
+	// lists. It defaults to every API call allowed by its roles.
+	AccessRules []AccessRule `json:"access_rules,omitempty"`
+
+	// ExpiresAt is the time the application credential expires at. It
+	// defaults to never.
+	ExpiresAt *time.Time `json:"-"`
+}
+
+// ToApplicationCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToApplicationCredentialCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "application_credential")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		b["application_credential"].(map[string]interface{})["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339MilliNoZ)
+	}
+
+	return b, nil
+}
+
+// Create creates a new application credential for a user, on the project the
+// token of the client is scoped to.
+func Create(client *gophercloud.ServiceClient, userID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToApplicationCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, userID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete deletes an application credential of a user.
+func Delete(client *gophercloud.ServiceClient, userID string, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, userID, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
+
+// ListAccessRules enumerates the access rules of the application credentials
+// of a user.
+func ListAccessRules(client *gophercloud.ServiceClient, userID string) pagination.Pager {
+	url := listAccessRulesURL(client, userID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AccessRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetAccessRule retrieves details on a single access rule of a user, by ID.
+func GetAccessRule(client *gophercloud.ServiceClient, userID string, id string) (r GetAccessRuleResult) {
+	_, r.Err = client.Get(getAccessRuleURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// DeleteAccessRule deletes an access rule of a user. It fails while an
+// application credential still uses it.
+func DeleteAccessRule(client *gophercloud.ServiceClient, userID string, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteAccessRuleURL(client, userID, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
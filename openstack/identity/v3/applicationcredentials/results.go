@@ -0,0 +1,207 @@
+package applicationcredentials
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role is a role an application credential is restricted to.
+type Role struct {
+	// ID is the ID of the role.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+
+	// DomainID is the ID of the domain of a domain-specific role.
+	DomainID string `json:"domain_id,omitempty"`
+}
+
+// AccessRule is an API call an application credential is allowed to make.
+type AccessRule struct {
+	// ID is the ID of the access rule. It is set by the Identity service.
+	ID string `json:"id,omitempty"`
+
+	// Path is the path of the API call, which may include wildcards: "*"
+	// matches a single segment, and "**" any number of them.
+	Path string `json:"path,omitempty"`
+
+	// Method is the HTTP method of the API call.
+	Method string `json:"method,omitempty"`
+
+	// Service is the type of the service the API call is made to, such as
+	// "compute".
+	Service string `json:"service,omitempty"`
+}
+
+// ApplicationCredential represents an application credential of a user.
+type ApplicationCredential struct {
+	// ID is the ID of the application credential.
+	ID string `json:"id"`
+
+	// Name is the name of the application credential.
+	Name string `json:"name"`
+
+	// Description is a description of the application credential.
+	Description string `json:"description"`
+
+	// Unrestricted tells whether the application credential may create and
+	// delete other application credentials and trusts.
+	Unrestricted bool `json:"unrestricted"`
+
+	// Secret is the secret of the application credential. It is only returned
+	// by Create.
+	Secret string `json:"secret"`
+
+	// ProjectID is the ID of the project the application credential is scoped
+	// to.
+	ProjectID string `json:"project_id"`
+
+	// Roles are the roles the application credential is restricted to.
+	Roles []Role `json:"roles"`
+
+	// AccessRules are the API calls the application credential is restricted
+	// to, if any.
+	AccessRules []AccessRule `json:"access_rules"`
+
+	// ExpiresAt is the time the application credential expires at. It is the
+	// zero time if it never expires.
+	ExpiresAt time.Time `json:"-"`
+
+	// Links contains referencing links to the application credential.
+	Links map[string]interface{} `json:"links"`
+}
+
+// UnmarshalJSON decodes an ApplicationCredential, parsing ExpiresAt with
+// gophercloud.JSONISO8601. A struct embedding ApplicationCredential inherits
+// this method, so it must decode its own fields separately.
+func (r *ApplicationCredential) UnmarshalJSON(b []byte) error {
+	type tmp ApplicationCredential
+	var s struct {
+		tmp
+		ExpiresAt gophercloud.JSONISO8601 `json:"expires_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = ApplicationCredential(s.tmp)
+	r.ExpiresAt = time.Time(s.ExpiresAt)
+	return nil
+}
+
+type applicationCredentialResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any applicationCredentialResult as an
+// ApplicationCredential.
+func (r applicationCredentialResult) Extract() (*ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredential *ApplicationCredential `json:"application_credential"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ApplicationCredential, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an ApplicationCredential.
+type GetResult struct {
+	applicationCredentialResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an ApplicationCredential.
+type CreateResult struct {
+	applicationCredentialResult
+}
+
+// DeleteResult is the response from a Delete or DeleteAccessRule operation.
+// Call its ExtractErr to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ApplicationCredentialPage is a single page of ApplicationCredential
+// results.
+type ApplicationCredentialPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an ApplicationCredentialPage contains any
+// results.
+func (r ApplicationCredentialPage) IsEmpty() (bool, error) {
+	applicationCredentials, err := ExtractApplicationCredentials(r)
+	return len(applicationCredentials) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ApplicationCredentialPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractApplicationCredentials returns a slice of ApplicationCredentials
+// contained in a single page of results.
+func ExtractApplicationCredentials(r pagination.Page) ([]ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredentials []ApplicationCredential `json:"application_credentials"`
+	}
+	err := (r.(ApplicationCredentialPage)).ExtractInto(&s)
+	return s.ApplicationCredentials, err
+}
+
+// GetAccessRuleResult is the response from a GetAccessRule operation. Call
+// its Extract method to interpret it as an AccessRule.
+type GetAccessRuleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetAccessRuleResult as an AccessRule.
+func (r GetAccessRuleResult) Extract() (*AccessRule, error) {
+	var s struct {
+		AccessRule *AccessRule `json:"access_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AccessRule, err
+}
+
+// AccessRulePage is a single page of AccessRule results.
+type AccessRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an AccessRulePage contains any results.
+func (r AccessRulePage) IsEmpty() (bool, error) {
+	accessRules, err := ExtractAccessRules(r)
+	return len(accessRules) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r AccessRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractAccessRules returns a slice of AccessRules contained in a single
+// page of results.
+func ExtractAccessRules(r pagination.Page) ([]AccessRule, error) {
+	var s struct {
+		AccessRules []AccessRule `json:"access_rules"`
+	}
+	err := (r.(AccessRulePage)).ExtractInto(&s)
+	return s.AccessRules, err
+}
@@ -0,0 +1,323 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const userID = "2844b2a08be147a08ef58317d6471f1f"
+
+// ListOutput provides a single page of ApplicationCredential results.
+const ListOutput = `
+{
+  "links": {
+    "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials",
+    "previous": null,
+    "next": null
+  },
+  "application_credentials": [
+    {
+      "id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+      "name": "test",
+      "description": "",
+      "unrestricted": false,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "expires_at": null,
+      "roles": [
+        {
+          "id": "31f87923ae4a4d119aa0b85dcdbeed13",
+          "domain_id": null,
+          "name": "compute_viewer"
+        }
+      ],
+      "links": {
+        "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+      }
+    },
+    {
+      "id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+      "name": "test2",
+      "description": "test2 description",
+      "unrestricted": true,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "expires_at": "2019-03-12T12:12:12.123456",
+      "roles": [
+        {
+          "id": "31f87923ae4a4d119aa0b85dcdbeed13",
+          "domain_id": null,
+          "name": "compute_viewer"
+        }
+      ],
+      "access_rules": [
+        {
+          "id": "07d719df00f349ef8de77d542edf010c",
+          "path": "/v2.1/servers",
+          "method": "GET",
+          "service": "compute"
+        }
+      ],
+      "links": {
+        "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+      }
+    }
+  ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+  "application_credential": {
+    "id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+    "name": "test",
+    "description": "",
+    "unrestricted": false,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "expires_at": null,
+    "roles": [
+      {
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13",
+        "domain_id": null,
+        "name": "compute_viewer"
+      }
+    ],
+    "links": {
+      "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+    }
+  }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+  "application_credential": {
+    "name": "test2",
+    "description": "test2 description",
+    "secret": "swordfish",
+    "unrestricted": true,
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "roles": [
+      {
+        "name": "compute_viewer"
+      }
+    ],
+    "access_rules": [
+      {
+        "path": "/v2.1/servers",
+        "method": "GET",
+        "service": "compute"
+      }
+    ]
+  }
+}
+`
+
+// CreateResponse provides a Create result.
+const CreateResponse = `
+{
+  "application_credential": {
+    "id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+    "name": "test2",
+    "description": "test2 description",
+    "secret": "swordfish",
+    "unrestricted": true,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "roles": [
+      {
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13",
+        "domain_id": null,
+        "name": "compute_viewer"
+      }
+    ],
+    "access_rules": [
+      {
+        "id": "07d719df00f349ef8de77d542edf010c",
+        "path": "/v2.1/servers",
+        "method": "GET",
+        "service": "compute"
+      }
+    ],
+    "links": {
+      "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+    }
+  }
+}
+`
+
+// ListAccessRulesOutput provides a single page of AccessRule results.
+const ListAccessRulesOutput = `
+{
+  "links": {
+    "self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/access_rules",
+    "previous": null,
+    "next": null
+  },
+  "access_rules": [
+    {
+      "id": "07d719df00f349ef8de77d542edf010c",
+      "path": "/v2.1/servers",
+      "method": "GET",
+      "service": "compute"
+    }
+  ]
+}
+`
+
+// GetAccessRuleOutput provides a GetAccessRule result.
+const GetAccessRuleOutput = `
+{
+  "access_rule": {
+    "id": "07d719df00f349ef8de77d542edf010c",
+    "path": "/v2.1/servers",
+    "method": "GET",
+    "service": "compute"
+  }
+}
+`
+
+var expiresAt = time.Date(2019, 3, 12, 12, 12, 12, 123456000, time.UTC)
+
+// ComputeViewer is the role of the application credentials.
+var ComputeViewer = applicationcredentials.Role{
+	ID:   "31f87923ae4a4d119aa0b85dcdbeed13",
+	Name: "compute_viewer",
+}
+
+// ListServers is the access rule of the second application credential.
+var ListServers = applicationcredentials.AccessRule{
+	ID:      "07d719df00f349ef8de77d542edf010c",
+	Path:    "/v2.1/servers",
+	Method:  "GET",
+	Service: "compute",
+}
+
+// FirstApplicationCredential is the first application credential in the List
+// response.
+var FirstApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:        "c4859fb437df4b87a51a8f5adcfb0bc7",
+	Name:      "test",
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Roles:     []applicationcredentials.Role{ComputeViewer},
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7",
+	},
+}
+
+// SecondApplicationCredential is the second application credential in the
+// List response.
+var SecondApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:           "6b8cc7647da64166a4a3cc0c88ebbabb",
+	Name:         "test2",
+	Description:  "test2 description",
+	Unrestricted: true,
+	ProjectID:    "53c2b94f63fb4f43a21b92d119ce549f",
+	ExpiresAt:    expiresAt,
+	Roles:        []applicationcredentials.Role{ComputeViewer},
+	AccessRules:  []applicationcredentials.AccessRule{ListServers},
+	Links: map[string]interface{}{
+		"self": "http://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb",
+	},
+}
+
+// ExpectedApplicationCredentialsSlice is the slice of application credentials
+// expected to be returned from ListOutput.
+var ExpectedApplicationCredentialsSlice = []applicationcredentials.ApplicationCredential{FirstApplicationCredential, SecondApplicationCredential}
+
+// HandleListApplicationCredentialsSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// responds with a list of two application credentials.
+func HandleListApplicationCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux that
+// responds with a single application credential.
+func HandleGetApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// tests application credential creation.
+func HandleCreateApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleDeleteApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux
+// that tests application credential deletion.
+func HandleDeleteApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListAccessRulesSuccessfully creates an HTTP handler at
+// `/users/{user_id}/access_rules` on the test handler mux that responds with
+// a list of one access rule.
+func HandleListAccessRulesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/access_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAccessRulesOutput)
+	})
+}
+
+// HandleAccessRuleSuccessfully creates an HTTP handler at
+// `/users/{user_id}/access_rules/{id}` on the test handler mux that responds
+// to GetAccessRule and DeleteAccessRule requests.
+func HandleAccessRuleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+userID+"/access_rules/07d719df00f349ef8de77d542edf010c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, GetAccessRuleOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
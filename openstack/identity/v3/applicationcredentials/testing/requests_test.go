@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListApplicationCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListApplicationCredentialsSuccessfully(t)
+
+	count := 0
+	err := applicationcredentials.List(client.ServiceClient(), userID, nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := applicationcredentials.ExtractApplicationCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedApplicationCredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetApplicationCredentialSuccessfully(t)
+
+	actual, err := applicationcredentials.Get(client.ServiceClient(), userID, "c4859fb437df4b87a51a8f5adcfb0bc7").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstApplicationCredential, *actual)
+}
+
+func TestCreateApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateApplicationCredentialSuccessfully(t)
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name:         "test2",
+		Description:  "test2 description",
+		Secret:       "swordfish",
+		Unrestricted: true,
+		ExpiresAt:    &expiresAt,
+		Roles: []applicationcredentials.Role{
+			{Name: "compute_viewer"},
+		},
+		AccessRules: []applicationcredentials.AccessRule{
+			{
+				Path:    "/v2.1/servers",
+				Method:  "GET",
+				Service: "compute",
+			},
+		},
+	}
+
+	expected := SecondApplicationCredential
+	expected.Secret = "swordfish"
+
+	actual, err := applicationcredentials.Create(client.ServiceClient(), userID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteApplicationCredentialSuccessfully(t)
+
+	res := applicationcredentials.Delete(client.ServiceClient(), userID, "c4859fb437df4b87a51a8f5adcfb0bc7")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListAccessRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAccessRulesSuccessfully(t)
+
+	allPages, err := applicationcredentials.ListAccessRules(client.ServiceClient(), userID).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := applicationcredentials.ExtractAccessRules(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []applicationcredentials.AccessRule{ListServers}, actual)
+}
+
+func TestGetAndDeleteAccessRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAccessRuleSuccessfully(t)
+
+	actual, err := applicationcredentials.GetAccessRule(client.ServiceClient(), userID, "07d719df00f349ef8de77d542edf010c").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ListServers, *actual)
+
+	res := applicationcredentials.DeleteAccessRule(client.ServiceClient(), userID, "07d719df00f349ef8de77d542edf010c")
+	th.AssertNoErr(t, res.Err)
+}
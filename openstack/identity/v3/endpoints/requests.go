@@ -97,6 +97,8 @@ func Update(client *gophercloud.ServiceClient, endpointID string, opts UpdateOpt
 
 // Delete removes an endpoint from the service catalog.
 func Delete(client *gophercloud.ServiceClient, endpointID string) (r DeleteResult) {
-	_, r.Err = client.Delete(endpointURL(client, endpointID), nil)
+	_, r.Err = client.Delete(endpointURL(client, endpointID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
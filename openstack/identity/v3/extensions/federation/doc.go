@@ -0,0 +1,76 @@
+/*
+Package federation authenticates federated users, whose identity is asserted
+by an identity provider trusted by the OS-FEDERATION extension of the
+OpenStack Identity service, and exchanges their assertion for an unscoped
+token.
+
+The identityproviders, mappings and protocols packages manage the identity
+providers, and how the users they assert are mapped to local identities.
+
+Example to Authenticate with an OpenID Connect Access Token
+
+	authOptions := federation.AuthOptions{
+		IdentityProvider: "corp",
+		Protocol:         "openid",
+		AccessToken:      "eyJhbGciOiJSUzI1NiJ9...",
+	}
+
+	token, err := federation.Create(identityClient, authOptions).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with the OpenID Connect Password Grant
+
+	authOptions := federation.AuthOptions{
+		IdentityProvider:  "corp",
+		Protocol:          "openid",
+		OIDCTokenEndpoint: "https://sso.example.com/realms/corp/protocol/openid-connect/token",
+		ClientID:          "openstack",
+		ClientSecret:      "secret",
+		Username:          "jdoe",
+		Password:          "password",
+	}
+
+	token, err := federation.Create(identityClient, authOptions).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with SAML2 ECP
+
+	authOptions := federation.AuthOptions{
+		IdentityProvider: "partner",
+		Protocol:         "saml2",
+		ECPEndpoint:      "https://idp.example.com/idp/profile/SAML2/SOAP/ECP",
+		Username:         "jdoe",
+		Password:         "password",
+	}
+
+	token, err := federation.Create(identityClient, authOptions).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a ProviderClient Scoped to a Project
+
+	provider, err := openstack.NewClient("https://example.com/identity/")
+	if err != nil {
+		panic(err)
+	}
+
+	authOptions := federation.AuthOptions{
+		IdentityProvider: "corp",
+		Protocol:         "openid",
+		AccessToken:      "eyJhbGciOiJSUzI1NiJ9...",
+		Scope: &gophercloud.AuthScope{
+			ProjectID: "263fd9",
+		},
+	}
+
+	err = openstack.AuthenticateV3Federated(provider, authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+*/
+package federation
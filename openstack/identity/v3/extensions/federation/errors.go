@@ -0,0 +1,46 @@
+package federation
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrAuthMethod indicates that not exactly one of AccessToken,
+// OIDCTokenEndpoint and ECPEndpoint was provided.
+type ErrAuthMethod struct{ gophercloud.BaseError }
+
+func (e ErrAuthMethod) Error() string {
+	return "Exactly one of AccessToken, OIDCTokenEndpoint or ECPEndpoint must be provided"
+}
+
+// ErrAccessTokenMissing indicates that the OpenID Connect provider answered
+// the password grant without an access token.
+type ErrAccessTokenMissing struct{ gophercloud.BaseError }
+
+func (e ErrAccessTokenMissing) Error() string {
+	return "The OpenID Connect provider did not return an access token"
+}
+
+// ErrPAOSRequestMissing indicates that the service provider didn't answer
+// with a SAML2 ECP authentication request, which happens when the protocol
+// isn't served by a SAML2 service provider supporting ECP.
+type ErrPAOSRequestMissing struct{ gophercloud.BaseError }
+
+func (e ErrPAOSRequestMissing) Error() string {
+	return "The service provider did not answer with a SAML2 ECP authentication request"
+}
+
+// ErrConsumerURLMismatch indicates that the identity provider asked for its
+// SAML2 assertion to be sent to another URL than the one the service provider
+// consumes assertions at. The assertion isn't sent, as it would authenticate
+// the user with whoever serves that URL.
+type ErrConsumerURLMismatch struct {
+	gophercloud.BaseError
+	ServiceProviderURL  string
+	IdentityProviderURL string
+}
+
+func (e ErrConsumerURLMismatch) Error() string {
+	return fmt.Sprintf("The identity provider asked for the assertion to be sent to %s instead of %s", e.IdentityProviderURL, e.ServiceProviderURL)
+}
@@ -0,0 +1,55 @@
+/*
+Package identityproviders manages the identity providers of the OS-FEDERATION
+extension of the OpenStack Identity service. An identity provider is a
+trusted source of federated users, such as an OpenID Connect provider or a
+SAML2 identity provider.
+
+Example to List Identity Providers
+
+	allPages, err := identityproviders.List(identityClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allIdentityProviders, err := identityproviders.ExtractIdentityProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, idp := range allIdentityProviders {
+		fmt.Printf("%+v\n", idp)
+	}
+
+Example to Create an Identity Provider
+
+	createOpts := identityproviders.CreateOpts{
+		Description: "Corporate OIDC",
+		DomainID:    "default",
+		Enabled:     gophercloud.Enabled,
+		RemoteIDs:   []string{"https://sso.example.com/realms/corp"},
+	}
+
+	idp, err := identityproviders.Create(identityClient, "corp", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Identity Provider
+
+	updateOpts := identityproviders.UpdateOpts{
+		Enabled: gophercloud.Disabled,
+	}
+
+	idp, err := identityproviders.Update(identityClient, "corp", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Identity Provider
+
+	err := identityproviders.Delete(identityClient, "corp").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package identityproviders
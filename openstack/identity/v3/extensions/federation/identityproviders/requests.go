@@ -0,0 +1,142 @@
+package identityproviders
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// ID filters the response by an identity provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled identity providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToIdentityProviderListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the identity providers.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return IdentityProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single identity provider, by ID.
+func Get(client *gophercloud.ServiceClient, idpID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, idpID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create an identity provider.
+type CreateOpts struct {
+	// Description is a description of the identity provider.
+	Description string `json:"description,omitempty"`
+
+	// DomainID is the ID of the domain federated users are created in. A
+	// domain is created for the identity provider if none is given.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AuthorizationTTL is the number of minutes group memberships of
+	// federated users remain valid after they last authenticated.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+
+	// RemoteIDs are the IDs the identity provider is known by in the
+	// assertions it issues, such as the issuer of its OIDC tokens.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+}
+
+// ToIdentityProviderCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToIdentityProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// Create registers an identity provider under the given ID.
+func Create(client *gophercloud.ServiceClient, idpID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating an identity provider.
+type UpdateOpts struct {
+	// Description is a description of the identity provider. Set it to an
+	// empty string to remove the description.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AuthorizationTTL is the number of minutes group memberships of
+	// federated users remain valid after they last authenticated.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+
+	// RemoteIDs replaces the IDs the identity provider is known by.
+	RemoteIDs *[]string `json:"remote_ids,omitempty"`
+}
+
+// ToIdentityProviderUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToIdentityProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// Update updates an existing identity provider.
+func Update(client *gophercloud.ServiceClient, idpID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an identity provider, along with its protocols.
+func Delete(client *gophercloud.ServiceClient, idpID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, idpID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
@@ -0,0 +1,104 @@
+package identityproviders
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// IdentityProvider is a trusted source of federated users.
+type IdentityProvider struct {
+	// ID is the unique ID of the identity provider.
+	ID string `json:"id"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description"`
+
+	// DomainID is the ID of the domain federated users are created in.
+	DomainID string `json:"domain_id"`
+
+	// Enabled is whether or not the identity provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// AuthorizationTTL is the number of minutes group memberships of
+	// federated users remain valid after they last authenticated. It is nil
+	// if the default of the Identity service applies.
+	AuthorizationTTL *int `json:"authorization_ttl"`
+
+	// RemoteIDs are the IDs the identity provider is known by in the
+	// assertions it issues.
+	RemoteIDs []string `json:"remote_ids"`
+
+	// Links contains referencing links to the identity provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+type identityProviderResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as an IdentityProvider.
+type GetResult struct {
+	identityProviderResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as an IdentityProvider.
+type CreateResult struct {
+	identityProviderResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an IdentityProvider.
+type UpdateResult struct {
+	identityProviderResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any identityProviderResult as an IdentityProvider.
+func (r identityProviderResult) Extract() (*IdentityProvider, error) {
+	var s struct {
+		IdentityProvider *IdentityProvider `json:"identity_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IdentityProvider, err
+}
+
+// IdentityProviderPage is a single page of IdentityProvider results.
+type IdentityProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of IdentityProviders contains any
+// results.
+func (r IdentityProviderPage) IsEmpty() (bool, error) {
+	idps, err := ExtractIdentityProviders(r)
+	return len(idps) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r IdentityProviderPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractIdentityProviders returns a slice of IdentityProviders contained in
+// a single page of results.
+func ExtractIdentityProviders(r pagination.Page) ([]IdentityProvider, error) {
+	var s struct {
+		IdentityProviders []IdentityProvider `json:"identity_providers"`
+	}
+	err := (r.(IdentityProviderPage)).ExtractInto(&s)
+	return s.IdentityProviders, err
+}
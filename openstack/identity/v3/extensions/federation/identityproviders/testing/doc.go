@@ -0,0 +1,2 @@
+// identityproviders unit tests
+package testing
@@ -0,0 +1,229 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/identityproviders"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of IdentityProvider results.
+const ListOutput = `
+{
+    "identity_providers": [
+        {
+            "authorization_ttl": null,
+            "description": "Corporate OIDC",
+            "domain_id": "default",
+            "enabled": true,
+            "id": "corp",
+            "links": {
+                "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+            },
+            "remote_ids": [
+                "https://sso.example.com/realms/corp"
+            ]
+        },
+        {
+            "authorization_ttl": 60,
+            "description": "Partner SAML2",
+            "domain_id": "2844b2a08be147a08ef58317d6471f1f",
+            "enabled": false,
+            "id": "partner",
+            "links": {
+                "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/partner/protocols",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/partner"
+            },
+            "remote_ids": []
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers"
+    }
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": null,
+        "description": "Corporate OIDC",
+        "domain_id": "default",
+        "enabled": true,
+        "id": "corp",
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+        },
+        "remote_ids": [
+            "https://sso.example.com/realms/corp"
+        ]
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "identity_provider": {
+        "description": "Corporate OIDC",
+        "domain_id": "default",
+        "enabled": true,
+        "remote_ids": [
+            "https://sso.example.com/realms/corp"
+        ]
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "identity_provider": {
+        "enabled": false,
+        "remote_ids": []
+    }
+}
+`
+
+// UpdateOutput provides an Update response.
+const UpdateOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": null,
+        "description": "Corporate OIDC",
+        "domain_id": "default",
+        "enabled": false,
+        "id": "corp",
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp"
+        },
+        "remote_ids": []
+    }
+}
+`
+
+var authorizationTTL = 60
+
+// FirstIdentityProvider is the first identity provider in the List request.
+var FirstIdentityProvider = identityproviders.IdentityProvider{
+	ID:          "corp",
+	Description: "Corporate OIDC",
+	DomainID:    "default",
+	Enabled:     true,
+	RemoteIDs:   []string{"https://sso.example.com/realms/corp"},
+	Links: map[string]interface{}{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+	},
+}
+
+// SecondIdentityProvider is the second identity provider in the List request.
+var SecondIdentityProvider = identityproviders.IdentityProvider{
+	ID:               "partner",
+	Description:      "Partner SAML2",
+	DomainID:         "2844b2a08be147a08ef58317d6471f1f",
+	Enabled:          false,
+	AuthorizationTTL: &authorizationTTL,
+	RemoteIDs:        []string{},
+	Links: map[string]interface{}{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/partner/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/partner",
+	},
+}
+
+// FirstIdentityProviderUpdated is how FirstIdentityProvider should look
+// after an Update.
+var FirstIdentityProviderUpdated = identityproviders.IdentityProvider{
+	ID:          "corp",
+	Description: "Corporate OIDC",
+	DomainID:    "default",
+	Enabled:     false,
+	RemoteIDs:   []string{},
+	Links: map[string]interface{}{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+	},
+}
+
+// ExpectedIdentityProvidersSlice is the slice of identity providers expected
+// to be returned from ListOutput.
+var ExpectedIdentityProvidersSlice = []identityproviders.IdentityProvider{FirstIdentityProvider, SecondIdentityProvider}
+
+// HandleListIdentityProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers` on the test handler mux that responds
+// with a list of two identity providers.
+func HandleListIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetIdentityProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp` on the test handler mux that
+// responds with a single identity provider.
+func HandleGetIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateIdentityProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp` on the test handler mux that tests
+// identity provider creation.
+func HandleCreateIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateIdentityProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp` on the test handler mux that tests
+// identity provider update.
+func HandleUpdateIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteIdentityProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp` on the test handler mux that tests
+// identity provider deletion.
+func HandleDeleteIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/identityproviders"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListIdentityProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	count := 0
+	err := identityproviders.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := identityproviders.ExtractIdentityProviders(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedIdentityProvidersSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListIdentityProvidersAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	allPages, err := identityproviders.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := identityproviders.ExtractIdentityProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedIdentityProvidersSlice, actual)
+}
+
+func TestListIdentityProvidersQuery(t *testing.T) {
+	listOpts := identityproviders.ListOpts{
+		ID:      "corp",
+		Enabled: gophercloud.Enabled,
+	}
+
+	query, err := listOpts.ToIdentityProviderListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?enabled=true&id=corp", query)
+}
+
+func TestGetIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetIdentityProviderSuccessfully(t)
+
+	actual, err := identityproviders.Get(client.ServiceClient(), "corp").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstIdentityProvider, *actual)
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateIdentityProviderSuccessfully(t)
+
+	createOpts := identityproviders.CreateOpts{
+		Description: "Corporate OIDC",
+		DomainID:    "default",
+		Enabled:     gophercloud.Enabled,
+		RemoteIDs:   []string{"https://sso.example.com/realms/corp"},
+	}
+
+	actual, err := identityproviders.Create(client.ServiceClient(), "corp", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstIdentityProvider, *actual)
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateIdentityProviderSuccessfully(t)
+
+	updateOpts := identityproviders.UpdateOpts{
+		Enabled:   gophercloud.Disabled,
+		RemoteIDs: &[]string{},
+	}
+
+	actual, err := identityproviders.Update(client.ServiceClient(), "corp", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstIdentityProviderUpdated, *actual)
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteIdentityProviderSuccessfully(t)
+
+	res := identityproviders.Delete(client.ServiceClient(), "corp")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,13 @@
+package identityproviders
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "OS-FEDERATION/identity_providers"
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, idpID string) string {
+	return client.ServiceURL(resourcePath, idpID)
+}
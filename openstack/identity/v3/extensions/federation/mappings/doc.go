@@ -0,0 +1,64 @@
+/*
+Package mappings manages the mappings of the OS-FEDERATION extension of the
+OpenStack Identity service. A mapping translates the attributes asserted by
+an identity provider into local users, groups and project role assignments.
+
+Example to List Mappings
+
+	allPages, err := mappings.List(identityClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMappings, err := mappings.ExtractMappings(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, mapping := range allMappings {
+		fmt.Printf("%+v\n", mapping)
+	}
+
+Example to Create a Mapping
+
+	createOpts := mappings.CreateOpts{
+		Rules: []mappings.MappingRule{
+			{
+				Local: []mappings.RuleLocal{
+					{
+						User: &mappings.RuleUser{
+							Name: "{0}",
+						},
+					},
+					{
+						Group: &mappings.Group{
+							ID: "0cd5e9",
+						},
+					},
+				},
+				Remote: []mappings.RemoteMatcher{
+					{
+						Type: "OIDC-preferred_username",
+					},
+					{
+						Type:     "OIDC-groups",
+						AnyOneOf: []string{"developers"},
+					},
+				},
+			},
+		},
+	}
+
+	mapping, err := mappings.Create(identityClient, "corp-mapping", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Mapping
+
+	err := mappings.Delete(identityClient, "corp-mapping").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package mappings
@@ -0,0 +1,87 @@
+package mappings
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List enumerates the mappings.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, rootURL(client), func(r pagination.PageResult) pagination.Page {
+		return MappingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single mapping, by ID.
+func Get(client *gophercloud.ServiceClient, mappingID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, mappingID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToMappingCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a mapping.
+type CreateOpts struct {
+	// Rules are the rules used to map federated users to local ones.
+	Rules []MappingRule `json:"rules" required:"true"`
+}
+
+// ToMappingCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToMappingCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// Create creates a mapping under the given ID.
+func Create(client *gophercloud.ServiceClient, mappingID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMappingCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToMappingUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a mapping.
+type UpdateOpts struct {
+	// Rules replaces the rules of the mapping.
+	Rules []MappingRule `json:"rules" required:"true"`
+}
+
+// ToMappingUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToMappingUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// Update replaces the rules of an existing mapping.
+func Update(client *gophercloud.ServiceClient, mappingID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToMappingUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a mapping.
+func Delete(client *gophercloud.ServiceClient, mappingID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, mappingID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
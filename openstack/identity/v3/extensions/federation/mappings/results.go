@@ -0,0 +1,207 @@
+package mappings
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Mapping translates the attributes asserted by an identity provider into
+// local identities.
+type Mapping struct {
+	// ID is the unique ID of the mapping.
+	ID string `json:"id"`
+
+	// Rules are the rules of the mapping.
+	Rules []MappingRule `json:"rules"`
+
+	// Links contains referencing links to the mapping.
+	Links map[string]interface{} `json:"links"`
+}
+
+// MappingRule maps federated users matching all of its Remote matchers to
+// the identities described by its Local entries.
+type MappingRule struct {
+	// Local describes the local identities the federated user maps to.
+	Local []RuleLocal `json:"local"`
+
+	// Remote lists the conditions the asserted attributes must meet for the
+	// rule to apply. Values of matched attributes can be referenced from
+	// Local as {0}, {1} and so on.
+	Remote []RemoteMatcher `json:"remote"`
+}
+
+// RuleLocal is a local identity a federated user maps to.
+type RuleLocal struct {
+	// Domain is the domain of the local identity.
+	Domain *Domain `json:"domain,omitempty"`
+
+	// Group is a group the federated user is made a member of.
+	Group *Group `json:"group,omitempty"`
+
+	// GroupIDs references a remote attribute holding group IDs the federated
+	// user is made a member of.
+	GroupIDs string `json:"group_ids,omitempty"`
+
+	// Groups references a remote attribute holding group names the federated
+	// user is made a member of.
+	Groups string `json:"groups,omitempty"`
+
+	// Projects are projects the federated user is granted roles on, which
+	// are created if they do not exist.
+	Projects []RuleProject `json:"projects,omitempty"`
+
+	// User describes the local user.
+	User *RuleUser `json:"user,omitempty"`
+}
+
+// Domain references a local domain.
+type Domain struct {
+	// ID is the ID of the domain.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the domain.
+	Name string `json:"name,omitempty"`
+}
+
+// Group references a local group.
+type Group struct {
+	// ID is the ID of the group.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the group.
+	Name string `json:"name,omitempty"`
+
+	// Domain is the domain of the group, required if the group is referenced
+	// by name.
+	Domain *Domain `json:"domain,omitempty"`
+}
+
+// RuleProject is a project a federated user is granted roles on.
+type RuleProject struct {
+	// Name is the name of the project.
+	Name string `json:"name"`
+
+	// Roles are the roles granted on the project.
+	Roles []RuleRole `json:"roles"`
+}
+
+// RuleRole references a role by name.
+type RuleRole struct {
+	// Name is the name of the role.
+	Name string `json:"name"`
+}
+
+// RuleUser describes the local user a federated user maps to.
+type RuleUser struct {
+	// Domain is the domain of the user.
+	Domain *Domain `json:"domain,omitempty"`
+
+	// Email is the email of the user.
+	Email string `json:"email,omitempty"`
+
+	// ID is the ID of the user.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the user.
+	Name string `json:"name,omitempty"`
+
+	// Type is either "ephemeral" (the default) or "local", for users which
+	// exist in the Identity service.
+	Type string `json:"type,omitempty"`
+}
+
+// RemoteMatcher is a condition on an attribute asserted by the identity
+// provider. Without any of AnyOneOf, NotAnyOf, Blacklist or Whitelist the
+// attribute only needs to be present.
+type RemoteMatcher struct {
+	// Type is the name of the asserted attribute.
+	Type string `json:"type"`
+
+	// AnyOneOf matches if the attribute has any of the given values.
+	AnyOneOf []string `json:"any_one_of,omitempty"`
+
+	// NotAnyOf matches if the attribute has none of the given values.
+	NotAnyOf []string `json:"not_any_of,omitempty"`
+
+	// Regex treats the values of AnyOneOf, NotAnyOf, Blacklist and Whitelist
+	// as regular expressions.
+	Regex *bool `json:"regex,omitempty"`
+
+	// Blacklist removes the given values from the attribute before it is
+	// referenced from Local.
+	Blacklist []string `json:"blacklist,omitempty"`
+
+	// Whitelist keeps only the given values of the attribute before it is
+	// referenced from Local.
+	Whitelist []string `json:"whitelist,omitempty"`
+}
+
+type mappingResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Mapping.
+type GetResult struct {
+	mappingResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Mapping.
+type CreateResult struct {
+	mappingResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Mapping.
+type UpdateResult struct {
+	mappingResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any mappingResult as a Mapping.
+func (r mappingResult) Extract() (*Mapping, error) {
+	var s struct {
+		Mapping *Mapping `json:"mapping"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Mapping, err
+}
+
+// MappingPage is a single page of Mapping results.
+type MappingPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Mappings contains any results.
+func (r MappingPage) IsEmpty() (bool, error) {
+	mappings, err := ExtractMappings(r)
+	return len(mappings) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r MappingPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractMappings returns a slice of Mappings contained in a single page of
+// results.
+func ExtractMappings(r pagination.Page) ([]Mapping, error) {
+	var s struct {
+		Mappings []Mapping `json:"mappings"`
+	}
+	err := (r.(MappingPage)).ExtractInto(&s)
+	return s.Mappings, err
+}
@@ -0,0 +1,2 @@
+// mappings unit tests
+package testing
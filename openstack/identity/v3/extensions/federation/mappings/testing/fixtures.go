@@ -0,0 +1,376 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/mappings"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Mapping results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/mappings"
+    },
+    "mappings": [
+        {
+            "id": "corp-mapping",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping"
+            },
+            "rules": [
+                {
+                    "local": [
+                        {
+                            "user": {
+                                "name": "{0}"
+                            }
+                        },
+                        {
+                            "group": {
+                                "id": "0cd5e9"
+                            }
+                        }
+                    ],
+                    "remote": [
+                        {
+                            "type": "OIDC-preferred_username"
+                        },
+                        {
+                            "type": "OIDC-groups",
+                            "any_one_of": [
+                                "developers"
+                            ]
+                        }
+                    ]
+                }
+            ]
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "mapping": {
+        "id": "corp-mapping",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping"
+        },
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "group": {
+                            "id": "0cd5e9"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "any_one_of": [
+                            "developers"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "mapping": {
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "group": {
+                            "id": "0cd5e9"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-groups",
+                        "any_one_of": [
+                            "developers"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "mapping": {
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}",
+                            "domain": {
+                                "name": "corp"
+                            }
+                        },
+                        "projects": [
+                            {
+                                "name": "{0}-sandbox",
+                                "roles": [
+                                    {
+                                        "name": "member"
+                                    }
+                                ]
+                            }
+                        ]
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-email",
+                        "regex": true,
+                        "any_one_of": [
+                            ".*@example\\.com$"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// UpdateOutput provides an Update response.
+const UpdateOutput = `
+{
+    "mapping": {
+        "id": "corp-mapping",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping"
+        },
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}",
+                            "domain": {
+                                "name": "corp"
+                            }
+                        },
+                        "projects": [
+                            {
+                                "name": "{0}-sandbox",
+                                "roles": [
+                                    {
+                                        "name": "member"
+                                    }
+                                ]
+                            }
+                        ]
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "OIDC-preferred_username"
+                    },
+                    {
+                        "type": "OIDC-email",
+                        "regex": true,
+                        "any_one_of": [
+                            ".*@example\\.com$"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// MappingRules are the rules of CorpMapping.
+var MappingRules = []mappings.MappingRule{
+	{
+		Local: []mappings.RuleLocal{
+			{
+				User: &mappings.RuleUser{
+					Name: "{0}",
+				},
+			},
+			{
+				Group: &mappings.Group{
+					ID: "0cd5e9",
+				},
+			},
+		},
+		Remote: []mappings.RemoteMatcher{
+			{
+				Type: "OIDC-preferred_username",
+			},
+			{
+				Type:     "OIDC-groups",
+				AnyOneOf: []string{"developers"},
+			},
+		},
+	},
+}
+
+var regex = true
+
+// UpdatedMappingRules are the rules of CorpMappingUpdated.
+var UpdatedMappingRules = []mappings.MappingRule{
+	{
+		Local: []mappings.RuleLocal{
+			{
+				User: &mappings.RuleUser{
+					Name: "{0}",
+					Domain: &mappings.Domain{
+						Name: "corp",
+					},
+				},
+				Projects: []mappings.RuleProject{
+					{
+						Name:  "{0}-sandbox",
+						Roles: []mappings.RuleRole{{Name: "member"}},
+					},
+				},
+			},
+		},
+		Remote: []mappings.RemoteMatcher{
+			{
+				Type: "OIDC-preferred_username",
+			},
+			{
+				Type:     "OIDC-email",
+				Regex:    &regex,
+				AnyOneOf: []string{`.*@example\.com$`},
+			},
+		},
+	},
+}
+
+// CorpMapping is the mapping in the List request.
+var CorpMapping = mappings.Mapping{
+	ID:    "corp-mapping",
+	Rules: MappingRules,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping",
+	},
+}
+
+// CorpMappingUpdated is how CorpMapping should look after an Update.
+var CorpMappingUpdated = mappings.Mapping{
+	ID:    "corp-mapping",
+	Rules: UpdatedMappingRules,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/mappings/corp-mapping",
+	},
+}
+
+// ExpectedMappingsSlice is the slice of mappings expected to be returned from
+// ListOutput.
+var ExpectedMappingsSlice = []mappings.Mapping{CorpMapping}
+
+// HandleListMappingsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings` on the test handler mux that responds with a
+// list of mappings.
+func HandleListMappingsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/corp-mapping` on the test handler mux that
+// responds with a single mapping.
+func HandleGetMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/corp-mapping` on the test handler mux that tests
+// mapping creation.
+func HandleCreateMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/corp-mapping` on the test handler mux that tests
+// mapping update.
+func HandleUpdateMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/corp-mapping` on the test handler mux that tests
+// mapping deletion.
+func HandleDeleteMappingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/mappings/corp-mapping", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/mappings"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListMappings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListMappingsSuccessfully(t)
+
+	count := 0
+	err := mappings.List(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := mappings.ExtractMappings(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedMappingsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListMappingsAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListMappingsSuccessfully(t)
+
+	allPages, err := mappings.List(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := mappings.ExtractMappings(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedMappingsSlice, actual)
+}
+
+func TestGetMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetMappingSuccessfully(t)
+
+	actual, err := mappings.Get(client.ServiceClient(), "corp-mapping").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpMapping, *actual)
+}
+
+func TestCreateMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateMappingSuccessfully(t)
+
+	createOpts := mappings.CreateOpts{
+		Rules: MappingRules,
+	}
+
+	actual, err := mappings.Create(client.ServiceClient(), "corp-mapping", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpMapping, *actual)
+}
+
+func TestCreateMappingNoRules(t *testing.T) {
+	res := mappings.Create(client.ServiceClient(), "corp-mapping", mappings.CreateOpts{})
+	if res.Err == nil {
+		t.Fatal("Create should fail without rules")
+	}
+}
+
+func TestUpdateMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateMappingSuccessfully(t)
+
+	updateOpts := mappings.UpdateOpts{
+		Rules: UpdatedMappingRules,
+	}
+
+	actual, err := mappings.Update(client.ServiceClient(), "corp-mapping", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, CorpMappingUpdated, *actual)
+}
+
+func TestDeleteMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteMappingSuccessfully(t)
+
+	res := mappings.Delete(client.ServiceClient(), "corp-mapping")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,13 @@
+package mappings
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "OS-FEDERATION/mappings"
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, mappingID string) string {
+	return client.ServiceURL(resourcePath, mappingID)
+}
@@ -0,0 +1,41 @@
+/*
+Package protocols manages the protocols of identity providers of the
+OS-FEDERATION extension of the OpenStack Identity service. A protocol, such
+as "openid" or "saml2", binds an identity provider to the mapping applied to
+the users it authenticates.
+
+Example to List the Protocols of an Identity Provider
+
+	allPages, err := protocols.List(identityClient, "corp").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allProtocols, err := protocols.ExtractProtocols(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, protocol := range allProtocols {
+		fmt.Printf("%+v\n", protocol)
+	}
+
+Example to Create a Protocol
+
+	createOpts := protocols.CreateOpts{
+		MappingID: "corp-mapping",
+	}
+
+	protocol, err := protocols.Create(identityClient, "corp", "openid", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Protocol
+
+	err := protocols.Delete(identityClient, "corp", "openid").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package protocols
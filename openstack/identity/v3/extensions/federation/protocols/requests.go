@@ -0,0 +1,98 @@
+package protocols
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List enumerates the protocols of an identity provider.
+func List(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	return pagination.NewPager(client, rootURL(client, idpID), func(r pagination.PageResult) pagination.Page {
+		return ProtocolPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single protocol of an identity provider.
+func Get(client *gophercloud.ServiceClient, idpID, protocolID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, idpID, protocolID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToProtocolCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a protocol.
+type CreateOpts struct {
+	// MappingID is the ID of the mapping applied to users authenticating
+	// with the protocol.
+	MappingID string `json:"mapping_id" required:"true"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider. It overrides the Identity service configuration.
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToProtocolCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// Create adds a protocol to an identity provider.
+func Create(client *gophercloud.ServiceClient, idpID, protocolID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToProtocolCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToProtocolUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a protocol.
+type UpdateOpts struct {
+	// MappingID is the ID of the mapping applied to users authenticating
+	// with the protocol.
+	MappingID string `json:"mapping_id" required:"true"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider. Set it to an empty string to fall back to the
+	// Identity service configuration.
+	RemoteIDAttribute *string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToProtocolUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// Update updates a protocol of an identity provider.
+func Update(client *gophercloud.ServiceClient, idpID, protocolID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToProtocolUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete removes a protocol from an identity provider.
+func Delete(client *gophercloud.ServiceClient, idpID, protocolID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, idpID, protocolID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
@@ -0,0 +1,93 @@
+package protocols
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Protocol binds an identity provider to a mapping.
+type Protocol struct {
+	// ID is the name of the protocol, such as "openid" or "saml2".
+	ID string `json:"id"`
+
+	// MappingID is the ID of the mapping applied to users authenticating
+	// with the protocol.
+	MappingID string `json:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider, if set on the protocol.
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+
+	// Links contains referencing links to the protocol.
+	Links map[string]interface{} `json:"links"`
+}
+
+type protocolResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Protocol.
+type GetResult struct {
+	protocolResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Protocol.
+type CreateResult struct {
+	protocolResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Protocol.
+type UpdateResult struct {
+	protocolResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any protocolResult as a Protocol.
+func (r protocolResult) Extract() (*Protocol, error) {
+	var s struct {
+		Protocol *Protocol `json:"protocol"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Protocol, err
+}
+
+// ProtocolPage is a single page of Protocol results.
+type ProtocolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Protocols contains any results.
+func (r ProtocolPage) IsEmpty() (bool, error) {
+	protocols, err := ExtractProtocols(r)
+	return len(protocols) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ProtocolPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractProtocols returns a slice of Protocols contained in a single page of
+// results.
+func ExtractProtocols(r pagination.Page) ([]Protocol, error) {
+	var s struct {
+		Protocols []Protocol `json:"protocols"`
+	}
+	err := (r.(ProtocolPage)).ExtractInto(&s)
+	return s.Protocols, err
+}
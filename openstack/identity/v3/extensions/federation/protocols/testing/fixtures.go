@@ -0,0 +1,196 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/protocols"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Protocol results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols"
+    },
+    "protocols": [
+        {
+            "id": "openid",
+            "links": {
+                "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid"
+            },
+            "mapping_id": "corp-mapping",
+            "remote_id_attribute": "HTTP_OIDC_ISS"
+        },
+        {
+            "id": "mapped",
+            "links": {
+                "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/mapped"
+            },
+            "mapping_id": "corp-mapping"
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "protocol": {
+        "id": "openid",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid"
+        },
+        "mapping_id": "corp-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "protocol": {
+        "mapping_id": "corp-mapping",
+        "remote_id_attribute": "HTTP_OIDC_ISS"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "protocol": {
+        "mapping_id": "corp-mapping-v2"
+    }
+}
+`
+
+// UpdateOutput provides an Update response.
+const UpdateOutput = `
+{
+    "protocol": {
+        "id": "openid",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid"
+        },
+        "mapping_id": "corp-mapping-v2",
+        "remote_id_attribute": "HTTP_OIDC_ISS"
+    }
+}
+`
+
+// OpenIDProtocol is the first protocol in the List request.
+var OpenIDProtocol = protocols.Protocol{
+	ID:                "openid",
+	MappingID:         "corp-mapping",
+	RemoteIDAttribute: "HTTP_OIDC_ISS",
+	Links: map[string]interface{}{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid",
+	},
+}
+
+// MappedProtocol is the second protocol in the List request.
+var MappedProtocol = protocols.Protocol{
+	ID:        "mapped",
+	MappingID: "corp-mapping",
+	Links: map[string]interface{}{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/mapped",
+	},
+}
+
+// OpenIDProtocolUpdated is how OpenIDProtocol should look after an Update.
+var OpenIDProtocolUpdated = protocols.Protocol{
+	ID:                "openid",
+	MappingID:         "corp-mapping-v2",
+	RemoteIDAttribute: "HTTP_OIDC_ISS",
+	Links: map[string]interface{}{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/corp/protocols/openid",
+	},
+}
+
+// ExpectedProtocolsSlice is the slice of protocols expected to be returned
+// from ListOutput.
+var ExpectedProtocolsSlice = []protocols.Protocol{OpenIDProtocol, MappedProtocol}
+
+// HandleListProtocolsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols` on the test handler mux
+// that responds with a list of two protocols.
+func HandleListProtocolsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid` on the test
+// handler mux that responds with a single protocol.
+func HandleGetProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid` on the test
+// handler mux that tests protocol creation.
+func HandleCreateProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid` on the test
+// handler mux that tests protocol update.
+func HandleUpdateProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid` on the test
+// handler mux that tests protocol deletion.
+func HandleDeleteProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation/protocols"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListProtocols(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProtocolsSuccessfully(t)
+
+	count := 0
+	err := protocols.List(client.ServiceClient(), "corp").EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := protocols.ExtractProtocols(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedProtocolsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetProtocolSuccessfully(t)
+
+	actual, err := protocols.Get(client.ServiceClient(), "corp", "openid").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, OpenIDProtocol, *actual)
+}
+
+func TestCreateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateProtocolSuccessfully(t)
+
+	createOpts := protocols.CreateOpts{
+		MappingID:         "corp-mapping",
+		RemoteIDAttribute: "HTTP_OIDC_ISS",
+	}
+
+	actual, err := protocols.Create(client.ServiceClient(), "corp", "openid", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, OpenIDProtocol, *actual)
+}
+
+func TestUpdateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateProtocolSuccessfully(t)
+
+	updateOpts := protocols.UpdateOpts{
+		MappingID: "corp-mapping-v2",
+	}
+
+	actual, err := protocols.Update(client.ServiceClient(), "corp", "openid", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, OpenIDProtocolUpdated, *actual)
+}
+
+func TestDeleteProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteProtocolSuccessfully(t)
+
+	res := protocols.Delete(client.ServiceClient(), "corp", "openid")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,11 @@
+package protocols
+
+import "github.com/gophercloud/gophercloud"
+
+func rootURL(client *gophercloud.ServiceClient, idpID string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols")
+}
+
+func resourceURL(client *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return client.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", protocolID)
+}
@@ -0,0 +1,168 @@
+package federation
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// AuthOptions holds the credentials of a federated user, and the identity
+// provider and protocol of the Identity service they are exchanged at.
+//
+// Exactly one way of authenticating must be provided: an OpenID Connect
+// access token, an OpenID Connect token endpoint to get one from with a
+// password grant, or a SAML2 ECP endpoint.
+type AuthOptions struct {
+	// IdentityProvider is the ID of the identity provider in the Identity
+	// service.
+	IdentityProvider string `required:"true"`
+
+	// Protocol is the ID of the protocol of the identity provider, such as
+	// "openid", "mapped" or "saml2".
+	Protocol string `required:"true"`
+
+	// AccessToken is an OpenID Connect access token issued by the identity
+	// provider.
+	AccessToken string
+
+	// OIDCTokenEndpoint is the token endpoint of the OpenID Connect provider.
+	// An access token is requested from it with the password grant, using
+	// Username, Password, ClientID and ClientSecret.
+	OIDCTokenEndpoint string
+
+	// ClientID and ClientSecret identify the OpenID Connect client. The
+	// secret is omitted for public clients.
+	ClientID     string
+	ClientSecret string
+
+	// OIDCScope is the scope requested with the password grant. It defaults
+	// to "openid".
+	OIDCScope string
+
+	// ECPEndpoint is the SAML2 ECP endpoint of the identity provider, which
+	// Username and Password are sent to.
+	ECPEndpoint string
+
+	// Username and Password are the credentials of the user at the identity
+	// provider, for the OpenID Connect password grant or SAML2 ECP.
+	Username string
+	Password string
+
+	// Scope, if set, is the scope openstack.AuthenticateV3Federated exchanges
+	// the unscoped federated token for. It isn't used by Create.
+	Scope *gophercloud.AuthScope
+
+	// AllowReauth allows openstack.AuthenticateV3Federated to go through the
+	// whole federated authentication again once its token expires. It isn't
+	// used by Create.
+	AllowReauth bool
+}
+
+// Create authenticates with the identity provider and exchanges the result at
+// the Identity service for an unscoped token, which is returned as a
+// tokens.CreateResult.
+func Create(c *gophercloud.ServiceClient, opts AuthOptions) (r tokens.CreateResult) {
+	if opts.IdentityProvider == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "IdentityProvider"}
+		return
+	}
+	if opts.Protocol == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Protocol"}
+		return
+	}
+
+	methods := 0
+	for _, v := range []string{opts.AccessToken, opts.OIDCTokenEndpoint, opts.ECPEndpoint} {
+		if v != "" {
+			methods++
+		}
+	}
+	if methods != 1 {
+		r.Err = ErrAuthMethod{}
+		return
+	}
+
+	if opts.ECPEndpoint != "" {
+		return createWithECP(c, opts)
+	}
+
+	accessToken := opts.AccessToken
+	if opts.OIDCTokenEndpoint != "" {
+		var err error
+		accessToken, err = passwordGrant(c, opts)
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+
+	resp, err := c.Post(authURL(c, opts.IdentityProvider, opts.Protocol), nil, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"X-Auth-Token":  "",
+			"Authorization": "Bearer " + accessToken,
+		},
+		OkCodes: []int{201},
+	})
+	if resp != nil {
+		r.Err = err
+		r.Header = resp.Header
+	}
+	return
+}
+
+// passwordGrant requests an access token from the OpenID Connect provider
+// with the password of the user.
+func passwordGrant(c *gophercloud.ServiceClient, opts AuthOptions) (string, error) {
+	if opts.ClientID == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "ClientID"}
+	}
+	if opts.Username == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "Username"}
+	}
+	if opts.Password == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "Password"}
+	}
+
+	scope := opts.OIDCScope
+	if scope == "" {
+		scope = "openid"
+	}
+
+	form := url.Values{
+		"grant_type": {"password"},
+		"username":   {opts.Username},
+		"password":   {opts.Password},
+		"scope":      {scope},
+	}
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+		"X-Auth-Token": "",
+	}
+	if opts.ClientSecret != "" {
+		credentials := url.QueryEscape(opts.ClientID) + ":" + url.QueryEscape(opts.ClientSecret)
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	} else {
+		// A public client only identifies itself.
+		form.Set("client_id", opts.ClientID)
+	}
+
+	var s struct {
+		AccessToken string `json:"access_token"`
+	}
+	_, err := c.ProviderClient.RequestWithContext(c.Context(), "POST", opts.OIDCTokenEndpoint, &gophercloud.RequestOpts{
+		RawBody:      strings.NewReader(form.Encode()),
+		JSONResponse: &s,
+		MoreHeaders:  headers,
+		OkCodes:      []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+	if s.AccessToken == "" {
+		return "", ErrAccessTokenMissing{}
+	}
+	return s.AccessToken, nil
+}
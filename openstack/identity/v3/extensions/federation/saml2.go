@@ -0,0 +1,220 @@
+package federation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+const (
+	soapNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	paosMediaType = "application/vnd.paos+xml"
+	paosHeader    = `ver="urn:liberty:paos:2003-08";"urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"`
+)
+
+// ecpEnvelope is a SOAP envelope exchanged during the SAML2 ECP flow. Only the
+// header blocks the flow relies on are decoded. The body is kept verbatim, as
+// it holds signed SAML2 messages.
+type ecpEnvelope struct {
+	Attrs  []xml.Attr `xml:",any,attr"`
+	Header struct {
+		// PAOSRequest is sent by the service provider, along with the URL it
+		// consumes assertions at.
+		PAOSRequest struct {
+			ResponseConsumerURL string `xml:"responseConsumerURL,attr"`
+		} `xml:"urn:liberty:paos:2003-08 Request"`
+
+		// RelayState is sent by the service provider, to be returned with
+		// the assertion.
+		RelayState *struct {
+			Value string `xml:",chardata"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp RelayState"`
+
+		// ECPResponse is sent by the identity provider, along with the URL
+		// the assertion is meant for.
+		ECPResponse struct {
+			AssertionConsumerServiceURL string `xml:"AssertionConsumerServiceURL,attr"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp Response"`
+	} `xml:"http://schemas.xmlsoap.org/soap/envelope/ Header"`
+	Body struct {
+		Inner []byte `xml:",innerxml"`
+	} `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+}
+
+// wrap returns a SOAP envelope holding body and, if not empty, header. The
+// namespaces declared on e are declared again, as body may use their prefixes.
+func (e ecpEnvelope) wrap(header string, body []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString(`<S:Envelope xmlns:S="` + soapNamespace + `"`)
+	for _, a := range e.Attrs {
+		name := ""
+		switch {
+		case a.Name.Space == "xmlns" && a.Name.Local != "S":
+			name = "xmlns:" + a.Name.Local
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			name = "xmlns"
+		default:
+			continue
+		}
+		b.WriteString(" " + name + `="`)
+		xml.EscapeText(&b, []byte(a.Value))
+		b.WriteString(`"`)
+	}
+	b.WriteString(">")
+	if header != "" {
+		b.WriteString("<S:Header>" + header + "</S:Header>")
+	}
+	b.WriteString("<S:Body>")
+	b.Write(body)
+	b.WriteString("</S:Body></S:Envelope>")
+	return b.Bytes()
+}
+
+// newECPClient returns a ServiceClient which sends the requests of the SAML2
+// ECP flow through the same pipeline as c, with its HTTP settings, hooks,
+// logger, retry policy and dry-run mode, but without its token, which must not
+// reach the identity provider, and without re-authenticating when the identity
+// provider rejects the credentials. Its cookie jar, which keeps the cookies the
+// service provider sets to bind the assertion it consumes to the session of the
+// authentication request, is only used for one flow.
+func newECPClient(c *gophercloud.ServiceClient) (*gophercloud.ServiceClient, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := c.HTTPClient
+	httpClient.Jar = jar
+
+	ecp := *c
+	ecp.ProviderClient = &gophercloud.ProviderClient{
+		IdentityBase:     c.IdentityBase,
+		IdentityEndpoint: c.IdentityEndpoint,
+		HTTPClient:       httpClient,
+		UserAgent:        c.UserAgent,
+		RetryPolicy:      c.RetryPolicy,
+		BeforeRequest:    c.ProviderClient.BeforeRequest,
+		AfterResponse:    c.ProviderClient.AfterResponse,
+		Logger:           c.Logger,
+		Debug:            c.Debug,
+		DryRun:           c.DryRun,
+	}
+	return &ecp, nil
+}
+
+// readBody reads and closes the body of a response to a request of the SAML2
+// ECP flow.
+func readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// createWithECP authenticates with the SAML2 ECP profile: the authentication
+// request of the service provider protecting the federated authentication URL
+// is sent to the identity provider along with the credentials of the user,
+// and the assertion it returns is sent back to the service provider, which
+// then lets the request for a token through.
+func createWithECP(c *gophercloud.ServiceClient, opts AuthOptions) (r tokens.CreateResult) {
+	if opts.Username == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Username"}
+		return
+	}
+	if opts.Password == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Password"}
+		return
+	}
+
+	ecp, err := newECPClient(c)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	// Get the authentication request of the service provider.
+	resp, err := ecp.Request("GET", authURL(c, opts.IdentityProvider, opts.Protocol), &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Accept": paosMediaType,
+			"PAOS":   paosHeader,
+		},
+		OkCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	b, err := readBody(resp)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	var spRequest ecpEnvelope
+	if err := xml.Unmarshal(b, &spRequest); err != nil || spRequest.Header.PAOSRequest.ResponseConsumerURL == "" {
+		r.Err = ErrPAOSRequestMissing{}
+		return
+	}
+
+	// Send it to the identity provider, without the headers meant for the
+	// client, to get an assertion.
+	credentials := base64.StdEncoding.EncodeToString([]byte(opts.Username + ":" + opts.Password))
+	resp, err = ecp.Request("POST", opts.ECPEndpoint, &gophercloud.RequestOpts{
+		RawBody: bytes.NewReader(spRequest.wrap("", spRequest.Body.Inner)),
+		MoreHeaders: map[string]string{
+			"Content-Type":  "text/xml; charset=utf-8",
+			"Accept":        "",
+			"Authorization": "Basic " + credentials,
+		},
+		OkCodes: []int{http.StatusOK},
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	b, err = readBody(resp)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	var idpResponse ecpEnvelope
+	if err := xml.Unmarshal(b, &idpResponse); err != nil {
+		r.Err = err
+		return
+	}
+
+	consumerURL := spRequest.Header.PAOSRequest.ResponseConsumerURL
+	if idpURL := idpResponse.Header.ECPResponse.AssertionConsumerServiceURL; idpURL != consumerURL {
+		r.Err = ErrConsumerURLMismatch{
+			ServiceProviderURL:  consumerURL,
+			IdentityProviderURL: idpURL,
+		}
+		return
+	}
+
+	// Send the assertion to the service provider, along with its relay state.
+	// It redirects to the federated authentication URL, which now issues a
+	// token.
+	var header string
+	if rs := spRequest.Header.RelayState; rs != nil {
+		var value bytes.Buffer
+		xml.EscapeText(&value, []byte(rs.Value))
+		header = fmt.Sprintf(`<ecp:RelayState xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1">%s</ecp:RelayState>`, value.String())
+	}
+	resp, err = ecp.Request("POST", consumerURL, &gophercloud.RequestOpts{
+		RawBody: bytes.NewReader(idpResponse.wrap(header, idpResponse.Body.Inner)),
+		MoreHeaders: map[string]string{
+			"Content-Type": paosMediaType,
+		},
+		JSONResponse: &r.Body,
+		OkCodes:      []int{http.StatusCreated},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
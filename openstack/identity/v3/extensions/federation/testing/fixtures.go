@@ -0,0 +1,142 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// TokenOutput is the unscoped token issued for a federated user.
+const TokenOutput = `
+{
+    "token": {
+        "methods": ["openid"],
+        "user": {
+            "domain": {
+                "id": "Federated",
+                "name": "Federated"
+            },
+            "id": "a2ebe6b",
+            "name": "jdoe",
+            "OS-FEDERATION": {
+                "groups": [{"id": "0cd5e9"}],
+                "identity_provider": {"id": "corp"},
+                "protocol": {"id": "openid"}
+            }
+        },
+        "audit_ids": ["VcxU2JYqT8OzfUVvrjEITQ"],
+        "expires_at": "2013-02-02T18:30:59.000000Z",
+        "issued_at": "2013-02-01T18:30:59.000000Z"
+    }
+}
+`
+
+// AuthnRequestOutput is the SAML2 ECP authentication request of the service
+// provider.
+const AuthnRequestOutput = `<?xml version="1.0" encoding="UTF-8"?>
+<S:Envelope xmlns:S="http://schemas.xmlsoap.org/soap/envelope/" xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol"><S:Header><paos:Request xmlns:paos="urn:liberty:paos:2003-08" S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1" responseConsumerURL="%s" service="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"/><ecp:RelayState xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1">ss:mem:6a5a6d</ecp:RelayState></S:Header><S:Body><samlp:AuthnRequest ID="_af71d8" Version="2.0"/></S:Body></S:Envelope>`
+
+// AssertionOutput is the SAML2 ECP response of the identity provider.
+const AssertionOutput = `<?xml version="1.0" encoding="UTF-8"?>
+<soap11:Envelope xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/" xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol"><soap11:Header><ecp:Response xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" AssertionConsumerServiceURL="%s" soap11:actor="http://schemas.xmlsoap.org/soap/actor/next" soap11:mustUnderstand="1"/></soap11:Header><soap11:Body><saml2p:Response ID="_5e3b1f" InResponseTo="_af71d8" Version="2.0"/></soap11:Body></soap11:Envelope>`
+
+// HandleFederatedAuthSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/corp/protocols/openid/auth` on the test
+// handler mux that issues a token for the given OpenID Connect access token.
+func HandleFederatedAuthSuccessfully(t *testing.T, accessToken string) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/corp/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", "Bearer "+accessToken)
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		w.Header().Add("X-Subject-Token", "federated-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, TokenOutput)
+	})
+}
+
+// HandleTokenEndpointSuccessfully creates an HTTP handler at `/oidc/token` on
+// the test handler mux that grants an access token to jdoe.
+func HandleTokenEndpointSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/oidc/token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		th.AssertEquals(t, true, ok)
+		th.AssertEquals(t, "openstack", clientID)
+		th.AssertEquals(t, "client-secret", clientSecret)
+
+		th.AssertNoErr(t, r.ParseForm())
+		th.AssertEquals(t, "password", r.PostForm.Get("grant_type"))
+		th.AssertEquals(t, "jdoe", r.PostForm.Get("username"))
+		th.AssertEquals(t, "secret", r.PostForm.Get("password"))
+		th.AssertEquals(t, "openid", r.PostForm.Get("scope"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "granted-access-token", "token_type": "Bearer", "expires_in": 300}`)
+	})
+}
+
+// HandleECPSuccessfully creates HTTP handlers on the test handler mux for a
+// SAML2 ECP flow: the service provider at
+// `/OS-FEDERATION/identity_providers/partner/protocols/saml2/auth` and
+// `/Shibboleth.sso/SAML2/ECP`, and the identity provider at `/idp/ecp`. The
+// identity provider asks for the assertion to be sent to consumerURL.
+func HandleECPSuccessfully(t *testing.T, consumerURL string) {
+	authURL := "/OS-FEDERATION/identity_providers/partner/protocols/saml2/auth"
+
+	th.Mux.HandleFunc(authURL, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		if _, err := r.Cookie("_shibsession"); err == nil {
+			th.TestHeader(t, r, "Accept", "application/json")
+			w.Header().Add("X-Subject-Token", "federated-token")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, TokenOutput)
+			return
+		}
+
+		th.TestHeader(t, r, "Accept", "application/vnd.paos+xml")
+		th.TestHeader(t, r, "PAOS", `ver="urn:liberty:paos:2003-08";"urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"`)
+
+		w.Header().Set("Content-Type", "application/vnd.paos+xml")
+		fmt.Fprintf(w, AuthnRequestOutput, th.Endpoint()+"Shibboleth.sso/SAML2/ECP")
+	})
+
+	th.Mux.HandleFunc("/idp/ecp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", "")
+
+		username, password, ok := r.BasicAuth()
+		th.AssertEquals(t, true, ok)
+		th.AssertEquals(t, "jdoe", username)
+		th.AssertEquals(t, "secret", password)
+
+		body, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, `<S:Envelope xmlns:S="http://schemas.xmlsoap.org/soap/envelope/" xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol"><S:Body><samlp:AuthnRequest ID="_af71d8" Version="2.0"/></S:Body></S:Envelope>`, string(body))
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, AssertionOutput, consumerURL)
+	})
+
+	th.Mux.HandleFunc("/Shibboleth.sso/SAML2/ECP", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/vnd.paos+xml")
+
+		body, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, true, strings.Contains(string(body), `<S:Header><ecp:RelayState xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1">ss:mem:6a5a6d</ecp:RelayState></S:Header>`))
+		th.AssertEquals(t, true, strings.Contains(string(body), `<S:Body><saml2p:Response ID="_5e3b1f" InResponseTo="_af71d8" Version="2.0"/></S:Body>`))
+		th.AssertEquals(t, true, strings.Contains(string(body), `xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol"`))
+
+		http.SetCookie(w, &http.Cookie{Name: "_shibsession", Value: "7c1a7e", Path: "/"})
+		http.Redirect(w, r, authURL, http.StatusFound)
+	})
+}
@@ -0,0 +1,143 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+var expectedExpiration, _ = time.Parse(time.RFC3339, "2013-02-02T18:30:59Z")
+
+func TestCreateWithAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFederatedAuthSuccessfully(t, "oidc-access-token")
+
+	options := federation.AuthOptions{
+		IdentityProvider: "corp",
+		Protocol:         "openid",
+		AccessToken:      "oidc-access-token",
+	}
+
+	token, err := federation.Create(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "federated-token", token.ID)
+	th.CheckEquals(t, expectedExpiration, token.ExpiresAt)
+}
+
+func TestCreateWithPasswordGrant(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTokenEndpointSuccessfully(t)
+	HandleFederatedAuthSuccessfully(t, "granted-access-token")
+
+	options := federation.AuthOptions{
+		IdentityProvider:  "corp",
+		Protocol:          "openid",
+		OIDCTokenEndpoint: th.Endpoint() + "oidc/token",
+		ClientID:          "openstack",
+		ClientSecret:      "client-secret",
+		Username:          "jdoe",
+		Password:          "secret",
+	}
+
+	token, err := federation.Create(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "federated-token", token.ID)
+}
+
+func TestCreateWithECP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleECPSuccessfully(t, th.Endpoint()+"Shibboleth.sso/SAML2/ECP")
+
+	options := federation.AuthOptions{
+		IdentityProvider: "partner",
+		Protocol:         "saml2",
+		ECPEndpoint:      th.Endpoint() + "idp/ecp",
+		Username:         "jdoe",
+		Password:         "secret",
+	}
+
+	token, err := federation.Create(client.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "federated-token", token.ID)
+	th.CheckEquals(t, expectedExpiration, token.ExpiresAt)
+}
+
+func TestCreateWithECPHooks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleECPSuccessfully(t, th.Endpoint()+"Shibboleth.sso/SAML2/ECP")
+
+	var urls []string
+	sc := client.ServiceClient()
+	sc.ProviderClient.BeforeRequest = []gophercloud.BeforeRequestHook{
+		func(req *http.Request) error {
+			urls = append(urls, req.URL.String())
+			return nil
+		},
+	}
+
+	options := federation.AuthOptions{
+		IdentityProvider: "partner",
+		Protocol:         "saml2",
+		ECPEndpoint:      th.Endpoint() + "idp/ecp",
+		Username:         "jdoe",
+		Password:         "secret",
+	}
+
+	_, err := federation.Create(sc, options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{
+		th.Endpoint() + "OS-FEDERATION/identity_providers/partner/protocols/saml2/auth",
+		th.Endpoint() + "idp/ecp",
+		th.Endpoint() + "Shibboleth.sso/SAML2/ECP",
+	}, urls)
+}
+
+func TestCreateWithECPConsumerURLMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleECPSuccessfully(t, "https://attacker.example.com/consume")
+
+	options := federation.AuthOptions{
+		IdentityProvider: "partner",
+		Protocol:         "saml2",
+		ECPEndpoint:      th.Endpoint() + "idp/ecp",
+		Username:         "jdoe",
+		Password:         "secret",
+	}
+
+	_, err := federation.Create(client.ServiceClient(), options).Extract()
+	mismatch, ok := err.(federation.ErrConsumerURLMismatch)
+	if !ok {
+		t.Fatalf("Expected ErrConsumerURLMismatch, got %#v", err)
+	}
+	th.CheckEquals(t, "https://attacker.example.com/consume", mismatch.IdentityProviderURL)
+}
+
+func TestCreateFailureAuthMethod(t *testing.T) {
+	for _, options := range []federation.AuthOptions{
+		{
+			IdentityProvider: "corp",
+			Protocol:         "openid",
+		},
+		{
+			IdentityProvider:  "corp",
+			Protocol:          "openid",
+			AccessToken:       "oidc-access-token",
+			OIDCTokenEndpoint: "https://sso.example.com/token",
+		},
+	} {
+		_, err := federation.Create(client.ServiceClient(), options).Extract()
+		if _, ok := err.(federation.ErrAuthMethod); !ok {
+			t.Errorf("Expected ErrAuthMethod, got %#v", err)
+		}
+	}
+}
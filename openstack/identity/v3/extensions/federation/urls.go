@@ -0,0 +1,7 @@
+package federation
+
+import "github.com/gophercloud/gophercloud"
+
+func authURL(c *gophercloud.ServiceClient, idpID, protocol string) string {
+	return c.ServiceURL("OS-FEDERATION", "identity_providers", idpID, "protocols", protocol, "auth")
+}
@@ -0,0 +1,92 @@
+/*
+Package trusts manages the trusts of the OS-TRUST extension of the OpenStack
+Identity service. A trust delegates roles of a user, the trustor, on a project
+to another user, the trustee.
+
+Example to Create a Trust
+
+	expiresAt := time.Date(2029, 12, 31, 0, 0, 0, 0, time.UTC)
+	createOpts := trusts.CreateOpts{
+		ExpiresAt:     &expiresAt,
+		Impersonation: true,
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles: []trusts.Role{
+			{
+				Name: "member",
+			},
+		},
+		TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}
+
+	trust, err := trusts.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Trust: %+v\n", trust)
+
+Example to List Trusts
+
+	listOpts := trusts.ListOpts{
+		TrustorUserID: "3422b7c113894f5d90665e1a79655e23",
+	}
+
+	allPages, err := trusts.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTrusts, err := trusts.ExtractTrusts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trust := range allTrusts {
+		fmt.Printf("%+v\n", trust)
+	}
+
+Example to List the Roles Delegated by a Trust
+
+	trustID := "3422b7c113894f5d90665e1a79655e23"
+
+	allPages, err := trusts.ListRoles(identityClient, trustID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := trusts.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range allRoles {
+		fmt.Printf("%+v\n", role)
+	}
+
+Example to Delete a Trust
+
+	trustID := "3422b7c113894f5d90665e1a79655e23"
+	err := trusts.Delete(identityClient, trustID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate as the Trustee
+
+	authOptions := gophercloud.AuthOptions{
+		IdentityEndpoint: "https://example.com:5000/v3",
+		UserID:           "ecb37e88cc86431c99d0332208cb6fbf",
+		Password:         "secret",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			TrustID: "3422b7c113894f5d90665e1a79655e23",
+		},
+	}
+
+	provider, err := openstack.AuthenticatedClient(authOptions)
+	if err != nil {
+		panic(err)
+	}
+*/
+package trusts
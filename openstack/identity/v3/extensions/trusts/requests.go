@@ -0,0 +1,139 @@
+package trusts
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToTrustCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a new trust.
+type CreateOpts struct {
+	// Impersonation allows the trustee to impersonate the trustor: tokens
+	// scoped to the trust are issued to the trustor instead of the trustee.
+	Impersonation bool `json:"impersonation"`
+
+	// TrusteeUserID is the ID of the user the roles are delegated to.
+	TrusteeUserID string `json:"trustee_user_id" required:"true"`
+
+	// TrustorUserID is the ID of the user delegating the roles. It must be
+	// the user of the token of the client.
+	TrustorUserID string `json:"trustor_user_id" required:"true"`
+
+	// ProjectID is the ID of the project the roles are delegated on.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Roles are the roles of the trustor to delegate, by ID or by name.
+	Roles []Role `json:"roles,omitempty"`
+
+	// AllowRedelegation allows the trustee to create trusts from this one.
+	AllowRedelegation bool `json:"allow_redelegation,omitempty"`
+
+	// RedelegationCount is the maximum depth of the redelegation chain.
+	RedelegationCount int `json:"redelegation_count,omitempty"`
+
+	// RemainingUses is the number of tokens the trust may be used to get.
+	// It defaults to an unlimited number.
+	RemainingUses int `json:"remaining_uses,omitempty"`
+
+	// ExpiresAt is the time the trust expires at. It defaults to never.
+	ExpiresAt *time.Time `json:"-"`
+}
+
+// ToTrustCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToTrustCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		b["trust"].(map[string]interface{})["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339MilliNoZ)
+	}
+
+	return b, nil
+}
+
+// Create creates a new Trust.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrustCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToTrustListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// TrustorUserID filters the response by the ID of the trustor.
+	TrustorUserID string `q:"trustor_user_id"`
+
+	// TrusteeUserID filters the response by the ID of the trustee.
+	TrusteeUserID string `q:"trustee_user_id"`
+}
+
+// ToTrustListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTrustListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the Trusts to which the current token has access.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToTrustListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TrustPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single trust, by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// Delete deletes a trust. Tokens scoped to it are revoked.
+func Delete(client *gophercloud.ServiceClient, trustID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, trustID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
+
+// ListRoles lists the roles delegated by a trust.
+func ListRoles(client *gophercloud.ServiceClient, id string) pagination.Pager {
+	url := listRolesURL(client, id)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolesPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetRole retrieves a role delegated by a trust. It returns an ErrDefault404
+// if the trust doesn't delegate the role.
+func GetRole(client *gophercloud.ServiceClient, id string, roleID string) (r GetRoleResult) {
+	_, r.Err = client.Get(getRoleURL(client, id, roleID), &r.Body, nil)
+	return
+}
@@ -0,0 +1,190 @@
+package trusts
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role is a role delegated by a trust. It is identified by its ID or its
+// name.
+type Role struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Trust represents a delegated authority.
+type Trust struct {
+	// ID is the unique ID of the trust.
+	ID string `json:"id"`
+
+	// Impersonation is whether tokens scoped to the trust are issued to the
+	// trustor instead of the trustee.
+	Impersonation bool `json:"impersonation"`
+
+	// TrusteeUserID is the ID of the user the roles are delegated to.
+	TrusteeUserID string `json:"trustee_user_id"`
+
+	// TrustorUserID is the ID of the user delegating the roles.
+	TrustorUserID string `json:"trustor_user_id"`
+
+	// ProjectID is the ID of the project the roles are delegated on.
+	ProjectID string `json:"project_id"`
+
+	// Roles are the roles delegated by the trust.
+	Roles []Role `json:"roles"`
+
+	// AllowRedelegation is whether the trustee may create trusts from this
+	// one.
+	AllowRedelegation bool `json:"allow_redelegation"`
+
+	// RedelegatedTrustID is the ID of the trust this one was created from, if
+	// any.
+	RedelegatedTrustID string `json:"redelegated_trust_id"`
+
+	// RedelegationCount is the remaining depth of the redelegation chain.
+	RedelegationCount int `json:"redelegation_count"`
+
+	// RemainingUses is the number of tokens the trust may still be used to
+	// get. It is nil if that number is unlimited.
+	RemainingUses *int `json:"remaining_uses"`
+
+	// ExpiresAt is the time the trust expires at. It is the zero time if it
+	// never expires.
+	ExpiresAt time.Time `json:"-"`
+
+	// Links contains referencing links to the trust.
+	Links map[string]interface{} `json:"links"`
+}
+
+// UnmarshalJSON decodes a Trust, parsing ExpiresAt with
+// gophercloud.JSONISO8601. A struct embedding Trust inherits this method, so it
+// must decode its own fields separately.
+func (r *Trust) UnmarshalJSON(b []byte) error {
+	type tmp Trust
+	var s struct {
+		tmp
+		ExpiresAt gophercloud.JSONISO8601 `json:"expires_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Trust(s.tmp)
+	r.ExpiresAt = time.Time(s.ExpiresAt)
+	return nil
+}
+
+type trustResult struct {
+	gophercloud.Result
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Trust.
+type CreateResult struct {
+	trustResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Trust.
+type GetResult struct {
+	trustResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any trustResult as a Trust.
+func (r trustResult) Extract() (*Trust, error) {
+	var s struct {
+		Trust *Trust `json:"trust"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trust, err
+}
+
+// TrustPage is a single page of Trust results.
+type TrustPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Trusts contains any results.
+func (r TrustPage) IsEmpty() (bool, error) {
+	trusts, err := ExtractTrusts(r)
+	return len(trusts) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r TrustPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractTrusts returns a slice of Trusts contained in a single page of
+// results.
+func ExtractTrusts(r pagination.Page) ([]Trust, error) {
+	var s struct {
+		Trusts []Trust `json:"trusts"`
+	}
+	err := (r.(TrustPage)).ExtractInto(&s)
+	return s.Trusts, err
+}
+
+// RolesPage is a single page of the roles delegated by a trust.
+type RolesPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Roles contains any results.
+func (r RolesPage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(r)
+	return len(roles) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RolesPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRoles returns a slice of Roles contained in a single page of
+// results.
+func ExtractRoles(r pagination.Page) ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := (r.(RolesPage)).ExtractInto(&s)
+	return s.Roles, err
+}
+
+// GetRoleResult is the response from a GetRole operation. Call its Extract
+// method to interpret it as a Role.
+type GetRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetRoleResult as a Role.
+func (r GetRoleResult) Extract() (*Role, error) {
+	var s struct {
+		Role *Role `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
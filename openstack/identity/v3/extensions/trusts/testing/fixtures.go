@@ -0,0 +1,257 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "trust": {
+        "expires_at": "2019-12-01T14:00:00.999999",
+        "impersonation": false,
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "roles": [
+            {
+                "name": "member"
+            }
+        ],
+        "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+        "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+    }
+}
+`
+
+// CreateResponse provides the output of a Create request.
+const CreateResponse = `
+{
+    "trust": {
+        "expires_at": "2019-12-01T14:00:00.999999Z",
+        "id": "3422b7c113894f5d90665e1a79655e23",
+        "impersonation": false,
+        "links": {
+            "self": "http://example.com/identity/v3/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23"
+        },
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "remaining_uses": null,
+        "roles": [
+            {
+                "id": "b627fca5-beb0-471a-9857-0e852b719e76",
+                "name": "member"
+            }
+        ],
+        "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+        "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+    }
+}
+`
+
+// ListOutput provides a single page of Trust results.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/OS-TRUST/trusts",
+        "previous": null,
+        "next": null
+    },
+    "trusts": [
+        {
+            "expires_at": "2019-12-01T14:00:00.999999Z",
+            "id": "3422b7c113894f5d90665e1a79655e23",
+            "impersonation": false,
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23"
+            },
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "remaining_uses": null,
+            "roles": [
+                {
+                    "id": "b627fca5-beb0-471a-9857-0e852b719e76",
+                    "name": "member"
+                }
+            ],
+            "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+            "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+        },
+        {
+            "expires_at": null,
+            "id": "e4a9b58b5d9b4b2c8c5b5e0c6b9c6f59",
+            "impersonation": true,
+            "links": {
+                "self": "http://example.com/identity/v3/OS-TRUST/trusts/e4a9b58b5d9b4b2c8c5b5e0c6b9c6f59"
+            },
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "remaining_uses": 3,
+            "roles": [],
+            "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+            "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+        }
+    ]
+}
+`
+
+// ListRolesOutput provides the roles delegated by a trust.
+const ListRolesOutput = `
+{
+    "links": {
+        "self": "http://example.com/identity/v3/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23/roles",
+        "previous": null,
+        "next": null
+    },
+    "roles": [
+        {
+            "id": "b627fca5-beb0-471a-9857-0e852b719e76",
+            "name": "member"
+        }
+    ]
+}
+`
+
+// GetRoleOutput provides a role delegated by a trust.
+const GetRoleOutput = `
+{
+    "role": {
+        "id": "b627fca5-beb0-471a-9857-0e852b719e76",
+        "name": "member"
+    }
+}
+`
+
+var threeUses = 3
+
+// FirstTrust is the first trust in the List request.
+var FirstTrust = trusts.Trust{
+	ID:            "3422b7c113894f5d90665e1a79655e23",
+	Impersonation: false,
+	TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+	TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+	Roles: []trusts.Role{
+		{
+			ID:   "b627fca5-beb0-471a-9857-0e852b719e76",
+			Name: "member",
+		},
+	},
+	ExpiresAt: time.Date(2019, 12, 1, 14, 0, 0, 999999000, time.UTC),
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23",
+	},
+}
+
+// SecondTrust is the second trust in the List request.
+var SecondTrust = trusts.Trust{
+	ID:            "e4a9b58b5d9b4b2c8c5b5e0c6b9c6f59",
+	Impersonation: true,
+	TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+	TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+	Roles:         []trusts.Role{},
+	RemainingUses: &threeUses,
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-TRUST/trusts/e4a9b58b5d9b4b2c8c5b5e0c6b9c6f59",
+	},
+}
+
+// ExpectedTrustsSlice is the slice of trusts expected to be returned from
+// ListOutput.
+var ExpectedTrustsSlice = []trusts.Trust{FirstTrust, SecondTrust}
+
+// ExpectedRole is the role delegated by FirstTrust.
+var ExpectedRole = trusts.Role{
+	ID:   "b627fca5-beb0-471a-9857-0e852b719e76",
+	Name: "member",
+}
+
+// HandleCreateTrust creates an HTTP handler at `/OS-TRUST/trusts` on the
+// test handler mux that tests trust creation.
+func HandleCreateTrust(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleListTrusts creates an HTTP handler at `/OS-TRUST/trusts` on the
+// test handler mux that responds with a list of two trusts.
+func HandleListTrusts(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetTrust creates an HTTP handler at
+// `/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23` on the test handler
+// mux that responds with a single trust.
+func HandleGetTrust(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+// HandleDeleteTrust creates an HTTP handler at
+// `/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23` on the test handler
+// mux that tests trust deletion.
+func HandleDeleteTrust(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListTrustRoles creates an HTTP handler at
+// `/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23/roles` on the test
+// handler mux that responds with the roles delegated by a trust.
+func HandleListTrustRoles(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListRolesOutput)
+	})
+}
+
+// HandleGetTrustRole creates an HTTP handler at
+// `/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23/roles/b627fca5-beb0-471a-9857-0e852b719e76`
+// on the test handler mux that responds with a role delegated by a trust.
+func HandleGetTrustRole(t *testing.T) {
+	th.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23/roles/b627fca5-beb0-471a-9857-0e852b719e76", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetRoleOutput)
+	})
+}
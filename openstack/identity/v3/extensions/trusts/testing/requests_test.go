@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/trusts"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestCreateTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrust(t)
+
+	expiresAt := time.Date(2019, 12, 1, 14, 0, 0, 999999999, time.UTC)
+	result, err := trusts.Create(client.ServiceClient(), trusts.CreateOpts{
+		ExpiresAt:     &expiresAt,
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles:         []trusts.Role{{Name: "member"}},
+		TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstTrust, *result)
+}
+
+func TestCreateTrustMissingTrustee(t *testing.T) {
+	res := trusts.Create(client.ServiceClient(), trusts.CreateOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestListTrusts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrusts(t)
+
+	count := 0
+	err := trusts.List(client.ServiceClient(), trusts.ListOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := trusts.ExtractTrusts(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedTrustsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrust(t)
+
+	actual, err := trusts.Get(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstTrust, *actual)
+}
+
+func TestDeleteTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteTrust(t)
+
+	res := trusts.Delete(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListTrustRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrustRoles(t)
+
+	allPages, err := trusts.ListRoles(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := trusts.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []trusts.Role{ExpectedRole}, actual)
+}
+
+func TestGetTrustRole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetTrustRole(t)
+
+	actual, err := trusts.GetRole(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23", "b627fca5-beb0-471a-9857-0e852b719e76").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRole, *actual)
+}
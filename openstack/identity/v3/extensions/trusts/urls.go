@@ -0,0 +1,21 @@
+package trusts
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "OS-TRUST/trusts"
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, trustID string) string {
+	return client.ServiceURL(resourcePath, trustID)
+}
+
+func listRolesURL(client *gophercloud.ServiceClient, trustID string) string {
+	return client.ServiceURL(resourcePath, trustID, "roles")
+}
+
+func getRoleURL(client *gophercloud.ServiceClient, trustID, roleID string) string {
+	return client.ServiceURL(resourcePath, trustID, "roles", roleID)
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	count := 0
+	err := groups.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := groups.ExtractGroups(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedGroupsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListGroupsAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListGroupsSuccessfully(t)
+
+	allPages, err := groups.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := groups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedGroupsSlice, actual)
+}
+
+func TestGetGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetGroupSuccessfully(t)
+
+	actual, err := groups.Get(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroup, *actual)
+}
+
+func TestCreateGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateGroupSuccessfully(t)
+
+	createOpts := groups.CreateOpts{
+		Name:        "support",
+		DomainID:    "1789d1",
+		Description: "group for support users",
+	}
+
+	actual, err := groups.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroup, *actual)
+}
+
+func TestUpdateGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateGroupSuccessfully(t)
+
+	description := "L2 Support Team"
+	updateOpts := groups.UpdateOpts{
+		Description: &description,
+	}
+
+	actual, err := groups.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondGroupUpdated, *actual)
+}
+
+func TestDeleteGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteGroupSuccessfully(t)
+
+	res := groups.Delete(client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, res.Err)
+}
@@ -1,3 +1,121 @@
-// Package roles provides information and interaction with the roles API
-// resource for the OpenStack Identity service.
+/*
+Package roles provides information and interaction with the roles API
+resource for the OpenStack Identity service.
+
+Example to List Roles
+
+	listOpts := roles.ListOpts{
+		DomainID: "default",
+	}
+
+	allPages, err := roles.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range allRoles {
+		fmt.Printf("%+v\n", role)
+	}
+
+Example to Create a Role
+
+	createOpts := roles.CreateOpts{
+		Name:     "read-only-admin",
+		DomainID: "default",
+	}
+
+	role, err := roles.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Role
+
+	roleID := "d9f5e2f35bd44cfe9a5ca1ce7bd4f0f3"
+	err := roles.Delete(identityClient, roleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Make a Role Imply Another
+
+	priorRoleID := "d9f5e2f35bd44cfe9a5ca1ce7bd4f0f3"
+	impliedRoleID := "4c4f6f3cb1e3443d91cf0e71c4c9ea14"
+
+	inference, err := roles.CreateImpliedRole(identityClient, priorRoleID, impliedRoleID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Role Assignments
+
+	listOpts := roles.ListAssignmentsOpts{
+		UserID: "97061de2ed0647b28a393c36ab584f39",
+	}
+
+	allPages, err := roles.ListAssignments(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoleAssignments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range allRoles {
+		fmt.Printf("%+v\n", role)
+	}
+
+Example to Assign a Role to a User in a Project
+
+	projectID := "a99e9b4e620e4db09a2dfb6e42a01e66"
+	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.Assign(identityClient, roleID, roles.AssignOpts{
+		UserID:    userID,
+		ProjectID: projectID,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Assign a Role to a Group on All the Projects of a Domain
+
+	domainID := "default"
+	groupID := "bede500ee1124ae9b0006ff859758b3a"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.Assign(identityClient, roleID, roles.AssignOpts{
+		GroupID:   groupID,
+		DomainID:  domainID,
+		Inherited: true,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unassign a Role From a User in a Project
+
+	projectID := "a99e9b4e620e4db09a2dfb6e42a01e66"
+	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.Unassign(identityClient, roleID, roles.UnassignOpts{
+		UserID:    userID,
+		ProjectID: projectID,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+*/
 package roles
@@ -5,6 +5,127 @@ import (
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToRoleListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// DomainID filters the response by a domain ID.
+	DomainID string `q:"domain_id"`
+
+	// Name filters the response by role name.
+	Name string `q:"name"`
+}
+
+// ToRoleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRoleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the roles to which the current token has access.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRoleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single role, by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToRoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a role.
+type CreateOpts struct {
+	// Name is the name of the new role.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain the role belongs to. A role without a
+	// domain is global.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is a description of the role.
+	Description string `json:"description,omitempty"`
+}
+
+// ToRoleCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToRoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Create creates a new Role.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRoleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a role.
+type UpdateOpts struct {
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+
+	// Description is a description of the role. Set it to an empty string to
+	// remove the description.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToRoleUpdateMap formats a UpdateOpts into an update request.
+func (opts UpdateOpts) ToRoleUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Update updates an existing Role.
+func Update(client *gophercloud.ServiceClient, roleID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRoleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, roleID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a role.
+func Delete(client *gophercloud.ServiceClient, roleID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, roleID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
+
 // ListAssignmentsOptsBuilder allows extensions to add additional parameters to
 // the ListAssignments request.
 type ListAssignmentsOptsBuilder interface {
@@ -23,6 +144,10 @@ type ListAssignmentsOpts struct {
 	ScopeProjectID string `q:"scope.project.id"`
 	UserID         string `q:"user.id"`
 	Effective      *bool  `q:"effective"`
+
+	// ScopeInheritedTo filters the response by assignments inherited by the
+	// projects of the scope, such as "projects".
+	ScopeInheritedTo string `q:"scope.OS-INHERIT:inherited_to"`
 }
 
 // ToRolesListAssignmentsQuery formats a ListAssignmentsOpts into a query string.
@@ -45,3 +170,217 @@ func ListAssignments(client *gophercloud.ServiceClient, opts ListAssignmentsOpts
 		return RoleAssignmentPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListImpliedRoles lists the roles implied by a prior role.
+func ListImpliedRoles(client *gophercloud.ServiceClient, priorRoleID string) (r ListImpliedRolesResult) {
+	_, r.Err = client.Get(listImpliedRolesURL(client, priorRoleID), &r.Body, nil)
+	return
+}
+
+// ListRoleInferenceRules lists all the role inference rules, that is the roles
+// implied by each prior role.
+func ListRoleInferenceRules(client *gophercloud.ServiceClient) (r ListRoleInferenceRulesResult) {
+	_, r.Err = client.Get(listRoleInferencesURL(client), &r.Body, nil)
+	return
+}
+
+// CreateImpliedRole creates a role inference rule: whoever is assigned the
+// prior role is also given the implied role.
+func CreateImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	_, r.Err = client.Put(impliedRoleURL(client, priorRoleID, impliedRoleID), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// GetImpliedRole retrieves a role inference rule. It returns an
+// ErrDefault404 if the prior role doesn't imply the other role.
+func GetImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	_, r.Err = client.Get(impliedRoleURL(client, priorRoleID, impliedRoleID), &r.Body, nil)
+	return
+}
+
+// DeleteImpliedRole deletes a role inference rule.
+func DeleteImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r DeleteImpliedRoleResult) {
+	_, r.Err = client.Delete(impliedRoleURL(client, priorRoleID, impliedRoleID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
+	return
+}
+
+// assignment returns the target and the actor of a role assignment: exactly
+// one of userID and groupID, and exactly one of projectID and domainID, must
+// be set.
+func assignment(userID, groupID, projectID, domainID string) (targetType, targetID, actorType, actorID string, err error) {
+	switch {
+	case userID != "" && groupID != "":
+		err = gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "UserID/GroupID"},
+			Value:           "both",
+		}
+		return
+	case userID != "":
+		actorType, actorID = "users", userID
+	case groupID != "":
+		actorType, actorID = "groups", groupID
+	default:
+		err = gophercloud.ErrMissingInput{Argument: "UserID/GroupID"}
+		return
+	}
+
+	switch {
+	case projectID != "" && domainID != "":
+		err = gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "ProjectID/DomainID"},
+			Value:           "both",
+		}
+	case projectID != "":
+		targetType, targetID = "projects", projectID
+	case domainID != "":
+		targetType, targetID = "domains", domainID
+	default:
+		err = gophercloud.ErrMissingInput{Argument: "ProjectID/DomainID"}
+	}
+	return
+}
+
+// ListAssignmentsOnResourceOpts provides options to list role assignments
+// of a user or a group on a project or a domain.
+type ListAssignmentsOnResourceOpts struct {
+	// UserID is the ID of a user to list role assignments of.
+	// Either UserID or GroupID must be provided.
+	UserID string
+
+	// GroupID is the ID of a group to list role assignments of.
+	// Either UserID or GroupID must be provided.
+	GroupID string
+
+	// ProjectID is the ID of a project to list role assignments on.
+	// Either ProjectID or DomainID must be provided.
+	ProjectID string
+
+	// DomainID is the ID of a domain to list role assignments on.
+	// Either ProjectID or DomainID must be provided.
+	DomainID string
+
+	// Inherited lists the roles inherited by the projects of the domain,
+	// through the OS-INHERIT extension, instead of the roles assigned on it.
+	// The OS-INHERIT extension only lists the roles inherited from a domain,
+	// so it can't be combined with ProjectID.
+	Inherited bool
+}
+
+// ListAssignmentsOnResource lists the roles assigned to a user or a group on
+// a project or a domain.
+func ListAssignmentsOnResource(client *gophercloud.ServiceClient, opts ListAssignmentsOnResourceOpts) pagination.Pager {
+	targetType, targetID, actorType, actorID, err := assignment(opts.UserID, opts.GroupID, opts.ProjectID, opts.DomainID)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	if opts.Inherited && opts.ProjectID != "" {
+		err := gophercloud.ErrInvalidInput{
+			ErrMissingInput: gophercloud.ErrMissingInput{Argument: "Inherited/ProjectID"},
+			Value:           opts.ProjectID,
+		}
+		return pagination.Pager{Err: err}
+	}
+	url := assignmentsOnResourceURL(client, targetType, targetID, actorType, actorID, opts.Inherited)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AssignOpts provides options to assign a role, or to check whether it is
+// assigned.
+type AssignOpts struct {
+	// UserID is the ID of a user to assign a role to.
+	// Either UserID or GroupID must be provided.
+	UserID string
+
+	// GroupID is the ID of a group to assign a role to.
+	// Either UserID or GroupID must be provided.
+	GroupID string
+
+	// ProjectID is the ID of a project to assign a role on.
+	// Either ProjectID or DomainID must be provided.
+	ProjectID string
+
+	// DomainID is the ID of a domain to assign a role on.
+	// Either ProjectID or DomainID must be provided.
+	DomainID string
+
+	// Inherited assigns the role to the projects of the target, and to
+	// projects created later, rather than to the target itself. It uses the
+	// OS-INHERIT extension.
+	Inherited bool
+}
+
+// UnassignOpts provides options to unassign a role.
+type UnassignOpts struct {
+	// UserID is the ID of a user to unassign a role from.
+	// Either UserID or GroupID must be provided.
+	UserID string
+
+	// GroupID is the ID of a group to unassign a role from.
+	// Either UserID or GroupID must be provided.
+	GroupID string
+
+	// ProjectID is the ID of a project to unassign a role on.
+	// Either ProjectID or DomainID must be provided.
+	ProjectID string
+
+	// DomainID is the ID of a domain to unassign a role on.
+	// Either ProjectID or DomainID must be provided.
+	DomainID string
+
+	// Inherited unassigns a role that was assigned with AssignOpts.Inherited.
+	Inherited bool
+}
+
+// Assign is the operation responsible for assigning a role
+// to a user/group on a project/domain.
+func Assign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	targetType, targetID, actorType, actorID, err := assignment(opts.UserID, opts.GroupID, opts.ProjectID, opts.DomainID)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(assignURL(client, targetType, targetID, actorType, actorID, roleID, opts.Inherited), nil, nil, &gophercloud.RequestOpts{
+		OkCodes:           []int{204},
+		DrainResponseBody: true,
+	})
+	return
+}
+
+// IsAssigned checks whether a role is assigned to a user/group on a
+// project/domain. Only direct assignments are considered, not those obtained
+// through group membership, inheritance or implied roles.
+func IsAssigned(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (bool, error) {
+	targetType, targetID, actorType, actorID, err := assignment(opts.UserID, opts.GroupID, opts.ProjectID, opts.DomainID)
+	if err != nil {
+		return false, err
+	}
+	resp, err := client.Request("HEAD", assignURL(client, targetType, targetID, actorType, actorID, roleID, opts.Inherited), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// Unassign is the operation responsible for unassigning a role
+// from a user/group on a project/domain.
+func Unassign(client *gophercloud.ServiceClient, roleID string, opts UnassignOpts) (r UnassignmentResult) {
+	targetType, targetID, actorType, actorID, err := assignment(opts.UserID, opts.GroupID, opts.ProjectID, opts.DomainID)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(assignURL(client, targetType, targetID, actorType, actorID, roleID, opts.Inherited), &gophercloud.RequestOpts{
+		OkCodes:           []int{204},
+		DrainResponseBody: true,
+	})
+	return
+}
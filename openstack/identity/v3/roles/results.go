@@ -1,6 +1,178 @@
 package roles
 
-import "github.com/gophercloud/gophercloud/pagination"
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role grants permissions to a user or a group on a project or a domain.
+type Role struct {
+	// DomainID is the domain ID the role belongs to. It is empty for global
+	// roles.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// ID is the unique ID of the role.
+	ID string `json:"id,omitempty"`
+
+	// Description is the description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links,omitempty"`
+
+	// Name is the role name.
+	Name string `json:"name,omitempty"`
+}
+
+type roleResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Role.
+type GetResult struct {
+	roleResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Role.
+type CreateResult struct {
+	roleResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Role.
+type UpdateResult struct {
+	roleResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// RolePage is a single page of Role results.
+type RolePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Roles contains any results.
+func (r RolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(r)
+	return len(roles) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r RolePage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRoles returns a slice of Roles contained in a single page of
+// results.
+func ExtractRoles(r pagination.Page) ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := (r.(RolePage)).ExtractInto(&s)
+	return s.Roles, err
+}
+
+// Extract interprets any roleResults as a Role.
+func (r roleResult) Extract() (*Role, error) {
+	var s struct {
+		Role *Role `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
+
+// RoleInference is a role inference rule: whoever is assigned PriorRole is
+// also given ImpliedRole.
+type RoleInference struct {
+	PriorRole   Role `json:"prior_role"`
+	ImpliedRole Role `json:"implies"`
+}
+
+// ImpliedRoles lists the roles implied by a prior role.
+type ImpliedRoles struct {
+	PriorRole    Role   `json:"prior_role"`
+	ImpliedRoles []Role `json:"implies"`
+}
+
+// ImpliedRoleResult is the response from a CreateImpliedRole or
+// GetImpliedRole operation. Call its Extract method to interpret it as a
+// RoleInference.
+type ImpliedRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImpliedRoleResult as a RoleInference.
+func (r ImpliedRoleResult) Extract() (*RoleInference, error) {
+	var s struct {
+		RoleInference *RoleInference `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// DeleteImpliedRoleResult is the response from a DeleteImpliedRole
+// operation. Call its ExtractErr to determine if the request succeeded or
+// failed.
+type DeleteImpliedRoleResult struct {
+	gophercloud.ErrResult
+}
+
+// ListImpliedRolesResult is the response from a ListImpliedRoles operation.
+// Call its Extract method to interpret it as an ImpliedRoles.
+type ListImpliedRolesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListImpliedRolesResult as an ImpliedRoles.
+func (r ListImpliedRolesResult) Extract() (*ImpliedRoles, error) {
+	var s struct {
+		RoleInference *ImpliedRoles `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// ListRoleInferenceRulesResult is the response from a ListRoleInferenceRules
+// operation. Call its Extract method to interpret it as a slice of
+// ImpliedRoles.
+type ListRoleInferenceRulesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListRoleInferenceRulesResult as a slice of
+// ImpliedRoles, one per prior role.
+func (r ListRoleInferenceRulesResult) Extract() ([]ImpliedRoles, error) {
+	var s struct {
+		RoleInferences []ImpliedRoles `json:"role_inferences"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInferences, err
+}
+
+// AssignmentResult represents the result of an assign operation.
+// Call ExtractErr method to determine if the request succeeded or failed.
+type AssignmentResult struct {
+	gophercloud.ErrResult
+}
+
+// UnassignmentResult represents the result of an unassign operation.
+// Call ExtractErr method to determine if the request succeeded or failed.
+type UnassignmentResult struct {
+	gophercloud.ErrResult
+}
 
 // RoleAssignment is the result of a role assignments query.
 type RoleAssignment struct {
@@ -10,13 +182,13 @@ type RoleAssignment struct {
 	Group Group `json:"group,omitempty"`
 }
 
-type Role struct {
-	ID string `json:"id,omitempty"`
-}
-
 type Scope struct {
 	Domain  Domain  `json:"domain,omitempty"`
 	Project Project `json:"project,omitempty"`
+
+	// InheritedTo is set, to "projects", for assignments that are inherited
+	// by the projects of the scope through the OS-INHERIT extension.
+	InheritedTo string `json:"OS-INHERIT:inherited_to,omitempty"`
 }
 
 type Domain struct {
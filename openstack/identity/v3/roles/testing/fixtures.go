@@ -0,0 +1,490 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of Role results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/roles"
+    },
+    "roles": [
+        {
+            "domain_id": "default",
+            "id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f"
+            },
+            "name": "admin-read-only"
+        },
+        {
+            "domain_id": "1789d1",
+            "id": "9fe1d3",
+            "description": "Support role",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "name": "support"
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "description": "Support role",
+        "links": {
+            "self": "https://example.com/identity/v3/roles/9fe1d3"
+        },
+        "name": "support"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "name": "support",
+        "description": "Support role"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "role": {
+        "description": "admin read-only support role"
+    }
+}
+`
+
+// UpdateOutput provides an update result.
+const UpdateOutput = `
+{
+    "role": {
+        "domain_id": "1789d1",
+        "id": "9fe1d3",
+        "description": "admin read-only support role",
+        "links": {
+            "self": "https://example.com/identity/v3/roles/9fe1d3"
+        },
+        "name": "support"
+    }
+}
+`
+
+// ImpliedRoleOutput provides the result of a CreateImpliedRole or
+// GetImpliedRole request.
+const ImpliedRoleOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "9fe1d3",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "name": "support"
+        },
+        "implies": {
+            "id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f"
+            },
+            "name": "admin-read-only"
+        }
+    },
+    "links": {
+        "self": "https://example.com/identity/v3/roles/9fe1d3/implies/2844b2a08be147a08ef58317d6471f1f"
+    }
+}
+`
+
+// ListImpliedRolesOutput provides the result of a ListImpliedRoles request.
+const ListImpliedRolesOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "9fe1d3",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "name": "support"
+        },
+        "implies": [
+            {
+                "id": "2844b2a08be147a08ef58317d6471f1f",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f"
+                },
+                "name": "admin-read-only"
+            }
+        ]
+    },
+    "links": {
+        "self": "https://example.com/identity/v3/roles/9fe1d3/implies"
+    }
+}
+`
+
+// ListRoleInferenceRulesOutput provides the result of a
+// ListRoleInferenceRules request.
+const ListRoleInferenceRulesOutput = `
+{
+    "role_inferences": [
+        {
+            "prior_role": {
+                "id": "9fe1d3",
+                "links": {
+                    "self": "https://example.com/identity/v3/roles/9fe1d3"
+                },
+                "name": "support"
+            },
+            "implies": [
+                {
+                    "id": "2844b2a08be147a08ef58317d6471f1f",
+                    "links": {
+                        "self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f"
+                    },
+                    "name": "admin-read-only"
+                }
+            ]
+        }
+    ],
+    "links": {
+        "self": "https://example.com/identity/v3/role_inferences"
+    }
+}
+`
+
+// ListAssignmentsOnResourceOutput provides the result of a
+// ListAssignmentsOnResource request.
+const ListAssignmentsOnResourceOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/projects/9e5a15/users/b964a9/roles"
+    },
+    "roles": [
+        {
+            "id": "9fe1d3",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "name": "support"
+        }
+    ]
+}
+`
+
+// ListInheritedAssignmentsOutput provides a single page of role assignments
+// inherited by the projects of a domain.
+const ListInheritedAssignmentsOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "https://example.com/identity/v3/OS-INHERIT/domains/default/groups/ea167b/roles/9fe1d3/inherited_to_projects"
+            },
+            "role": {
+                "id": "9fe1d3"
+            },
+            "scope": {
+                "domain": {
+                    "id": "default"
+                },
+                "OS-INHERIT:inherited_to": "projects"
+            },
+            "group": {
+                "id": "ea167b"
+            }
+        }
+    ],
+    "links": {
+        "self": "https://example.com/identity/v3/role_assignments?scope.OS-INHERIT:inherited_to=projects",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// FirstRole is the first role in the List request.
+var FirstRole = roles.Role{
+	DomainID: "default",
+	ID:       "2844b2a08be147a08ef58317d6471f1f",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f",
+	},
+	Name: "admin-read-only",
+}
+
+// SecondRole is the second role in the List request.
+var SecondRole = roles.Role{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Description: "Support role",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+	Name: "support",
+}
+
+// SecondRoleUpdated is how SecondRole should look after an Update.
+var SecondRoleUpdated = roles.Role{
+	DomainID:    "1789d1",
+	ID:          "9fe1d3",
+	Description: "admin read-only support role",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+	Name: "support",
+}
+
+// ExpectedRolesSlice is the slice of roles expected to be returned from ListOutput.
+var ExpectedRolesSlice = []roles.Role{FirstRole, SecondRole}
+
+// PriorRole is the prior role of the role inference rules.
+var PriorRole = roles.Role{
+	ID: "9fe1d3",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/roles/9fe1d3",
+	},
+	Name: "support",
+}
+
+// ImpliedRole is the role implied by PriorRole.
+var ImpliedRole = roles.Role{
+	ID: "2844b2a08be147a08ef58317d6471f1f",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/2844b2a08be147a08ef58317d6471f1f",
+	},
+	Name: "admin-read-only",
+}
+
+// ExpectedRoleInference is the RoleInference expected to be returned from
+// ImpliedRoleOutput.
+var ExpectedRoleInference = roles.RoleInference{
+	PriorRole:   PriorRole,
+	ImpliedRole: ImpliedRole,
+}
+
+// ExpectedImpliedRoles is the ImpliedRoles expected to be returned from
+// ListImpliedRolesOutput.
+var ExpectedImpliedRoles = roles.ImpliedRoles{
+	PriorRole:    PriorRole,
+	ImpliedRoles: []roles.Role{ImpliedRole},
+}
+
+// ExpectedInheritedAssignmentsSlice is the slice of role assignments expected
+// to be returned from ListInheritedAssignmentsOutput.
+var ExpectedInheritedAssignmentsSlice = []roles.RoleAssignment{
+	{
+		Role: roles.Role{ID: "9fe1d3"},
+		Scope: roles.Scope{
+			Domain:      roles.Domain{ID: "default"},
+			InheritedTo: "projects",
+		},
+		Group: roles.Group{ID: "ea167b"},
+	},
+}
+
+// HandleListRolesSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that responds with a list of two roles.
+func HandleListRolesSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "Accept", "application/json")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that responds with a single role.
+func HandleGetRoleSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "Accept", "application/json")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that tests role creation.
+func HandleCreateRoleSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that tests role update.
+func HandleUpdateRoleSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "PATCH")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteRoleSuccessfully creates an HTTP handler at `/roles` on the
+// test handler mux that tests role deletion.
+func HandleDeleteRoleSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "DELETE")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleImpliedRoleSuccessfully creates an HTTP handler at
+// `/roles/9fe1d3/implies/2844b2a08be147a08ef58317d6471f1f` on the test
+// handler mux that tests the creation, retrieval and deletion of a role
+// inference rule.
+func HandleImpliedRoleSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles/9fe1d3/implies/2844b2a08be147a08ef58317d6471f1f", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, ImpliedRoleOutput)
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, ImpliedRoleOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListImpliedRolesSuccessfully creates an HTTP handler at
+// `/roles/9fe1d3/implies` on the test handler mux that responds with the
+// roles implied by a role.
+func HandleListImpliedRolesSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/roles/9fe1d3/implies", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListImpliedRolesOutput)
+	})
+}
+
+// HandleListRoleInferenceRulesSuccessfully creates an HTTP handler at
+// `/role_inferences` on the test handler mux that responds with all the role
+// inference rules.
+func HandleListRoleInferenceRulesSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/role_inferences", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListRoleInferenceRulesOutput)
+	})
+}
+
+// HandleListAssignmentsOnResourceSuccessfully creates HTTP handlers for the
+// roles of a user on a project, and the roles of a group inherited by the
+// projects of a domain, that respond with a list of one role.
+func HandleListAssignmentsOnResourceSuccessfully(t *testing.T) {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "Accept", "application/json")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAssignmentsOnResourceOutput)
+	}
+
+	testhelper.Mux.HandleFunc("/projects/9e5a15/users/b964a9/roles", fn)
+	testhelper.Mux.HandleFunc("/OS-INHERIT/domains/default/groups/ea167b/roles/inherited_to_projects", fn)
+}
+
+// HandleListInheritedAssignmentsSuccessfully creates an HTTP handler at
+// `/role_assignments` on the test handler mux that responds with a list of one
+// inherited role assignment.
+func HandleListInheritedAssignmentsSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "Accept", "application/json")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestFormValues(t, r, map[string]string{"scope.OS-INHERIT:inherited_to": "projects"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListInheritedAssignmentsOutput)
+	})
+}
+
+// HandleAssignmentSuccessfully creates HTTP handlers for the direct
+// assignments of a role to a user on a project and for the inherited
+// assignments of the role to a group on a domain, that test assigning,
+// checking and unassigning the role.
+func HandleAssignmentSuccessfully(t *testing.T) {
+	fn := func() http.HandlerFunc {
+		assigned := false
+		return func(w http.ResponseWriter, r *http.Request) {
+			testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			switch r.Method {
+			case "PUT":
+				assigned = true
+			case "DELETE":
+				assigned = false
+			case "HEAD":
+				if !assigned {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+			default:
+				t.Errorf("Unexpected method %s", r.Method)
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+
+	testhelper.Mux.HandleFunc("/projects/9e5a15/users/b964a9/roles/9fe1d3", fn())
+	testhelper.Mux.HandleFunc("/OS-INHERIT/domains/default/groups/ea167b/roles/9fe1d3/inherited_to_projects", fn())
+}
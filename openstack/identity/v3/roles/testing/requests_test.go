@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
 	"github.com/gophercloud/gophercloud/pagination"
 	"github.com/gophercloud/gophercloud/testhelper"
@@ -103,3 +104,229 @@ func TestListSinglePage(t *testing.T) {
 		t.Errorf("Expected 1 page, got %d", count)
 	}
 }
+
+func TestListAssignmentsInheritedQuery(t *testing.T) {
+	opts := roles.ListAssignmentsOpts{
+		UserID:           "313233",
+		ScopeInheritedTo: "projects",
+	}
+
+	query, err := opts.ToRolesListAssignmentsQuery()
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "?scope.OS-INHERIT%3Ainherited_to=projects&user.id=313233", query)
+}
+
+func TestListAssignmentsInherited(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListInheritedAssignmentsSuccessfully(t)
+
+	allPages, err := roles.ListAssignments(client.ServiceClient(), roles.ListAssignmentsOpts{ScopeInheritedTo: "projects"}).AllPages()
+	testhelper.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoleAssignments(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedInheritedAssignmentsSlice, actual)
+}
+
+func TestListRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRolesSuccessfully(t)
+
+	count := 0
+	err := roles.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := roles.ExtractRoles(page)
+		testhelper.AssertNoErr(t, err)
+
+		testhelper.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, count, 1)
+}
+
+func TestListRolesAllPages(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRolesSuccessfully(t)
+
+	allPages, err := roles.List(client.ServiceClient(), nil).AllPages()
+	testhelper.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoles(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+}
+
+func TestGetRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleGetRoleSuccessfully(t)
+
+	actual, err := roles.Get(client.ServiceClient(), "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestCreateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleCreateRoleSuccessfully(t)
+
+	createOpts := roles.CreateOpts{
+		Name:        "support",
+		DomainID:    "1789d1",
+		Description: "Support role",
+	}
+
+	actual, err := roles.Create(client.ServiceClient(), createOpts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestUpdateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleUpdateRoleSuccessfully(t)
+
+	description := "admin read-only support role"
+	updateOpts := roles.UpdateOpts{
+		Description: &description,
+	}
+
+	actual, err := roles.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRoleUpdated, *actual)
+}
+
+func TestDeleteRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleDeleteRoleSuccessfully(t)
+
+	res := roles.Delete(client.ServiceClient(), "9fe1d3")
+	testhelper.AssertNoErr(t, res.Err)
+}
+
+func TestImpliedRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleImpliedRoleSuccessfully(t)
+
+	actual, err := roles.CreateImpliedRole(client.ServiceClient(), "9fe1d3", "2844b2a08be147a08ef58317d6471f1f").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRoleInference, *actual)
+
+	actual, err = roles.GetImpliedRole(client.ServiceClient(), "9fe1d3", "2844b2a08be147a08ef58317d6471f1f").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedRoleInference, *actual)
+
+	err = roles.DeleteImpliedRole(client.ServiceClient(), "9fe1d3", "2844b2a08be147a08ef58317d6471f1f").ExtractErr()
+	testhelper.AssertNoErr(t, err)
+}
+
+func TestListImpliedRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListImpliedRolesSuccessfully(t)
+
+	actual, err := roles.ListImpliedRoles(client.ServiceClient(), "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, ExpectedImpliedRoles, *actual)
+}
+
+func TestListRoleInferenceRules(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRoleInferenceRulesSuccessfully(t)
+
+	actual, err := roles.ListRoleInferenceRules(client.ServiceClient()).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []roles.ImpliedRoles{ExpectedImpliedRoles}, actual)
+}
+
+func TestListAssignmentsOnResource(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListAssignmentsOnResourceSuccessfully(t)
+
+	expected := []roles.Role{
+		{
+			ID: "9fe1d3",
+			Links: map[string]interface{}{
+				"self": "https://example.com/identity/v3/roles/9fe1d3",
+			},
+			Name: "support",
+		},
+	}
+
+	for _, opts := range []roles.ListAssignmentsOnResourceOpts{
+		{UserID: "b964a9", ProjectID: "9e5a15"},
+		{GroupID: "ea167b", DomainID: "default", Inherited: true},
+	} {
+		allPages, err := roles.ListAssignmentsOnResource(client.ServiceClient(), opts).AllPages()
+		testhelper.AssertNoErr(t, err)
+		actual, err := roles.ExtractRoles(allPages)
+		testhelper.AssertNoErr(t, err)
+		testhelper.CheckDeepEquals(t, expected, actual)
+	}
+}
+
+func TestListAssignmentsOnResourceInheritedFromProject(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	opts := roles.ListAssignmentsOnResourceOpts{UserID: "b964a9", ProjectID: "9e5a15", Inherited: true}
+	err := roles.ListAssignmentsOnResource(client.ServiceClient(), opts).EachPage(func(pagination.Page) (bool, error) {
+		return true, nil
+	})
+	testhelper.AssertDeepEquals(t, gophercloud.ErrInvalidInput{
+		ErrMissingInput: gophercloud.ErrMissingInput{Argument: "Inherited/ProjectID"},
+		Value:           "9e5a15",
+	}, err)
+}
+
+func TestAssignment(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleAssignmentSuccessfully(t)
+
+	for _, opts := range []roles.AssignOpts{
+		{UserID: "b964a9", ProjectID: "9e5a15"},
+		{GroupID: "ea167b", DomainID: "default", Inherited: true},
+	} {
+		ok, err := roles.IsAssigned(client.ServiceClient(), "9fe1d3", opts)
+		testhelper.AssertNoErr(t, err)
+		testhelper.AssertEquals(t, false, ok)
+
+		err = roles.Assign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+
+		ok, err = roles.IsAssigned(client.ServiceClient(), "9fe1d3", opts)
+		testhelper.AssertNoErr(t, err)
+		testhelper.AssertEquals(t, true, ok)
+
+		err = roles.Unassign(client.ServiceClient(), "9fe1d3", roles.UnassignOpts(opts)).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+
+		ok, err = roles.IsAssigned(client.ServiceClient(), "9fe1d3", opts)
+		testhelper.AssertNoErr(t, err)
+		testhelper.AssertEquals(t, false, ok)
+	}
+}
+
+func TestAssignmentInvalidOpts(t *testing.T) {
+	for _, opts := range []roles.AssignOpts{
+		{ProjectID: "9e5a15"},
+		{UserID: "b964a9"},
+		{UserID: "b964a9", GroupID: "ea167b", ProjectID: "9e5a15"},
+		{UserID: "b964a9", ProjectID: "9e5a15", DomainID: "default"},
+	} {
+		err := roles.Assign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		if err == nil {
+			t.Errorf("Expected error for %+v, got none", opts)
+		}
+	}
+}
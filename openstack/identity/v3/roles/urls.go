@@ -2,6 +2,65 @@ package roles
 
 import "github.com/gophercloud/gophercloud"
 
+const (
+	rolePath           = "roles"
+	roleInferencesPath = "role_inferences"
+	impliesPath        = "implies"
+	inheritPath        = "OS-INHERIT"
+	inheritedToPath    = "inherited_to_projects"
+)
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rolePath)
+}
+
+func getURL(client *gophercloud.ServiceClient, roleID string) string {
+	return client.ServiceURL(rolePath, roleID)
+}
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rolePath)
+}
+
+func updateURL(client *gophercloud.ServiceClient, roleID string) string {
+	return client.ServiceURL(rolePath, roleID)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, roleID string) string {
+	return client.ServiceURL(rolePath, roleID)
+}
+
 func listAssignmentsURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("role_assignments")
 }
+
+func listImpliedRolesURL(client *gophercloud.ServiceClient, priorRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, impliesPath)
+}
+
+func impliedRoleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, impliesPath, impliedRoleID)
+}
+
+func listRoleInferencesURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(roleInferencesPath)
+}
+
+// assignmentsOnResourceURL returns the URL of the roles of an actor (a user or
+// a group) on a target (a project or a domain). Inherited assignments are
+// managed through the OS-INHERIT extension.
+func assignmentsOnResourceURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID string, inherited bool) string {
+	if inherited {
+		return client.ServiceURL(inheritPath, targetType, targetID, actorType, actorID, rolePath, inheritedToPath)
+	}
+	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath)
+}
+
+// assignURL returns the URL of a single role assignment, see
+// assignmentsOnResourceURL.
+func assignURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string, inherited bool) string {
+	if inherited {
+		return client.ServiceURL(inheritPath, targetType, targetID, actorType, actorID, rolePath, roleID, inheritedToPath)
+	}
+	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath, roleID)
+}
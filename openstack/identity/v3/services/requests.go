@@ -59,6 +59,8 @@ func Update(client *gophercloud.ServiceClient, serviceID string, serviceType str
 // Delete removes an existing service.
 // It either deletes all associated endpoints, or fails until all endpoints are deleted.
 func Delete(client *gophercloud.ServiceClient, serviceID string) (r DeleteResult) {
-	_, r.Err = client.Delete(serviceURL(client, serviceID), nil)
+	_, r.Err = client.Delete(serviceURL(client, serviceID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
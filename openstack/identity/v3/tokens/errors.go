@@ -125,6 +125,8 @@ func (e ErrScopeProjectIDAlone) Error() string {
 }
 
 // ErrScopeDomainName indicates that a DomainName was provided alone in a Scope.
+//
+// Deprecated: a DomainName alone scopes the token to that domain, so this error is no longer returned.
 type ErrScopeDomainName struct{ gophercloud.BaseError }
 
 func (e ErrScopeDomainName) Error() string {
@@ -137,3 +139,63 @@ type ErrScopeEmpty struct{ gophercloud.BaseError }
 func (e ErrScopeEmpty) Error() string {
 	return "You must provide either a Project or Domain in a Scope"
 }
+
+// ErrAppCredMissingSecret indicates that an application credential was identified, but its secret was not provided.
+type ErrAppCredMissingSecret struct{ gophercloud.BaseError }
+
+func (e ErrAppCredMissingSecret) Error() string {
+	return "You must provide the secret of the application credential to authenticate with"
+}
+
+// ErrScopeWithAppCred indicates that a Scope was provided along with an application credential, which is always
+// scoped to its own project.
+type ErrScopeWithAppCred struct{ gophercloud.BaseError }
+
+func (e ErrScopeWithAppCred) Error() string {
+	return "A Scope may not be provided when authenticating with an application credential"
+}
+
+// ErrScopeSystemAlone indicates that a project or a domain was provided along with System in a Scope.
+type ErrScopeSystemAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeSystemAlone) Error() string {
+	return "ProjectID, ProjectName, DomainID, DomainName, and TrustID may not be provided with System in a Scope"
+}
+
+// ErrScopeTrustAlone indicates that a project or a domain was provided along with TrustID in a Scope.
+type ErrScopeTrustAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeTrustAlone) Error() string {
+	return "ProjectID, ProjectName, DomainID, and DomainName may not be provided with TrustID in a Scope"
+}
+
+// ErrScopeUnscopedAlone indicates that a scope was provided along with Unscoped in a Scope.
+type ErrScopeUnscopedAlone struct{ gophercloud.BaseError }
+
+func (e ErrScopeUnscopedAlone) Error() string {
+	return "No other field may be provided with Unscoped in a Scope"
+}
+
+// ErrAdditionalAuthRequired is returned by Create when the authentication
+// methods provided are valid, but don't satisfy the multi-factor
+// authentication rules of the user. Authentication is completed by sending
+// the ID of the Receipt, in AuthOptions.Receipt, along with the missing
+// methods, such as a Passcode.
+type ErrAdditionalAuthRequired struct {
+	gophercloud.BaseError
+
+	// Receipt is the auth receipt issued for the methods already satisfied.
+	Receipt Receipt
+
+	// Err is the ErrDefault401 Keystone responded with.
+	Err gophercloud.ErrDefault401
+}
+
+func (e ErrAdditionalAuthRequired) Error() string {
+	return fmt.Sprintf("Additional authentication methods are required, one of: %v", e.Receipt.RequiredAuthMethods)
+}
+
+// Unwrap returns the ErrDefault401 Keystone responded with.
+func (e ErrAdditionalAuthRequired) Unwrap() error {
+	return e.Err
+}
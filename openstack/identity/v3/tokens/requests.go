@@ -2,12 +2,31 @@ package tokens
 
 import "github.com/gophercloud/gophercloud"
 
-// Scope allows a created token to be limited to a specific domain or project.
+// Scope allows a created token to be limited to a specific domain or project,
+// or to the system.
 type Scope struct {
-	ProjectID   string `json:"scope.project.id,omitempty" not:"ProjectName,DomainID,DomainName"`
-	ProjectName string `json:"scope.project.name,omitempty"`
-	DomainID    string `json:"scope.project.id,omitempty" not:"ProjectName,ProjectID,DomainName"`
-	DomainName  string `json:"scope.project.id,omitempty"`
+	// ProjectID, or ProjectName along with DomainID or DomainName, scope the
+	// token to a project.
+	ProjectID   string
+	ProjectName string
+
+	// DomainID or DomainName, without a project, scope the token to a domain.
+	// With a ProjectName, they identify the domain of the project instead.
+	DomainID   string
+	DomainName string
+
+	// System scopes the token to the system, to perform operations on
+	// system-wide resources such as services and endpoints.
+	System bool
+
+	// Unscoped requests a token without any scope, even if the user has a
+	// default project.
+	Unscoped bool
+
+	// TrustID scopes the token to a trust of the OS-TRUST extension, to act
+	// as the trustee on behalf of the trustor, with the roles delegated by the
+	// trust on its project.
+	TrustID string
 }
 
 // AuthOptionsBuilder describes any argument that may be passed to the Create call.
@@ -33,8 +52,9 @@ type AuthOptions struct {
 	Password string `json:"password,omitempty"`
 
 	// At most one of DomainID and DomainName must be provided if using Username
-	// with Identity V3. Otherwise, either are optional.
-	DomainID   string `json:"id,omitempty"`
+	// with Identity V3. Otherwise, either are optional. They identify the
+	// domain of the user, not the one of the scope of the token.
+	DomainID   string `json:"-"`
 	DomainName string `json:"name,omitempty"`
 
 	// The TenantID and TenantName fields are optional for the Identity V2 API.
@@ -54,6 +74,42 @@ type AuthOptions struct {
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string
+
+	// ApplicationCredentialID and ApplicationCredentialSecret allow users to
+	// authenticate with an application credential. Instead of its ID, an
+	// application credential may be identified by its ApplicationCredentialName
+	// along with the UserID, or the Username and the DomainID or DomainName, of
+	// its owner. The token is scoped to the project of the application
+	// credential, so no Scope may be requested.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Passcode is a TOTP passcode of the user, for multi-factor
+	// authentication. It is sent along with the Password, if any, and
+	// identifies the user the same way.
+	Passcode string `json:"-"`
+
+	// Receipt is the ID of the auth receipt returned, in an
+	// ErrAdditionalAuthRequired, by a previous authentication attempt that
+	// didn't satisfy the multi-factor authentication rules of the user. The
+	// methods provided along with it complete that attempt.
+	Receipt string `json:"-"`
+}
+
+// AuthOptionsHeadersBuilder is implemented by the AuthOptionsBuilders that
+// need to send headers along with the Create request.
+type AuthOptionsHeadersBuilder interface {
+	ToTokenV3HeadersMap() (map[string]string, error)
+}
+
+// ToTokenV3HeadersMap sends the auth receipt, if any.
+func (opts AuthOptions) ToTokenV3HeadersMap() (map[string]string, error) {
+	headers := map[string]string{}
+	if opts.Receipt != "" {
+		headers[receiptHeader] = opts.Receipt
+	}
+	return headers, nil
 }
 
 func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}, error) {
@@ -79,24 +135,62 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 		User userReq `json:"user"`
 	}
 
+	type totpUserReq struct {
+		ID       *string    `json:"id,omitempty"`
+		Name     *string    `json:"name,omitempty"`
+		Domain   *domainReq `json:"domain,omitempty"`
+		Passcode string     `json:"passcode"`
+	}
+
+	type totpReq struct {
+		User totpUserReq `json:"user"`
+	}
+
 	type tokenReq struct {
 		ID string `json:"id"`
 	}
 
+	type applicationCredentialUserReq struct {
+		ID     *string    `json:"id,omitempty"`
+		Name   *string    `json:"name,omitempty"`
+		Domain *domainReq `json:"domain,omitempty"`
+	}
+
+	type applicationCredentialReq struct {
+		ID     *string                       `json:"id,omitempty"`
+		Name   *string                       `json:"name,omitempty"`
+		User   *applicationCredentialUserReq `json:"user,omitempty"`
+		Secret string                        `json:"secret"`
+	}
+
 	type identityReq struct {
-		Methods  []string     `json:"methods"`
-		Password *passwordReq `json:"password,omitempty"`
-		Token    *tokenReq    `json:"token,omitempty"`
+		Methods               []string                  `json:"methods"`
+		Password              *passwordReq              `json:"password,omitempty"`
+		Token                 *tokenReq                 `json:"token,omitempty"`
+		TOTP                  *totpReq                  `json:"totp,omitempty"`
+		ApplicationCredential *applicationCredentialReq `json:"application_credential,omitempty"`
+	}
+
+	type systemReq struct {
+		All bool `json:"all"`
+	}
+
+	type trustReq struct {
+		ID string `json:"id"`
 	}
 
 	type scopeReq struct {
 		Domain  *domainReq  `json:"domain,omitempty"`
 		Project *projectReq `json:"project,omitempty"`
+		System  *systemReq  `json:"system,omitempty"`
+		Trust   *trustReq   `json:"OS-TRUST:trust,omitempty"`
 	}
 
 	type authReq struct {
 		Identity identityReq `json:"identity"`
-		Scope    *scopeReq   `json:"scope,omitempty"`
+
+		// Scope is either a *scopeReq, or the "unscoped" string.
+		Scope interface{} `json:"scope,omitempty"`
 	}
 
 	type request struct {
@@ -115,7 +209,52 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 		return nil, ErrTenantNameProvided{}
 	}
 
-	if opts.Password == "" {
+	if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+		// Application credential authentication.
+		if opts.ApplicationCredentialSecret == "" {
+			return nil, ErrAppCredMissingSecret{}
+		}
+		if scope != nil {
+			return nil, ErrScopeWithAppCred{}
+		}
+
+		req.Auth.Identity.Methods = []string{"application_credential"}
+
+		if opts.ApplicationCredentialID != "" {
+			// The ID of an application credential is enough to identify it.
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				ID:     &opts.ApplicationCredentialID,
+				Secret: opts.ApplicationCredentialSecret,
+			}
+		} else {
+			// Its name is only unique among the application credentials of its owner.
+			var user *applicationCredentialUserReq
+			if opts.UserID != "" {
+				if opts.Username != "" {
+					return nil, ErrUsernameOrUserID{}
+				}
+				user = &applicationCredentialUserReq{ID: &opts.UserID}
+			} else if opts.Username != "" {
+				if (opts.DomainID == "") == (opts.DomainName == "") {
+					return nil, ErrDomainIDOrDomainName{}
+				}
+				user = &applicationCredentialUserReq{Name: &opts.Username}
+				if opts.DomainID != "" {
+					user.Domain = &domainReq{ID: &opts.DomainID}
+				} else {
+					user.Domain = &domainReq{Name: &opts.DomainName}
+				}
+			} else {
+				return nil, ErrUsernameOrUserID{}
+			}
+
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				Name:   &opts.ApplicationCredentialName,
+				User:   user,
+				Secret: opts.ApplicationCredentialSecret,
+			}
+		}
+	} else if opts.Password == "" && opts.Passcode == "" {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
 			// parameters.
@@ -142,14 +281,14 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 			return nil, ErrMissingPassword{}
 		}
 	} else {
-		// Password authentication.
-		req.Auth.Identity.Methods = []string{"password"}
+		// Password and/or TOTP authentication, which identify the user the same way.
 
 		// At least one of Username and UserID must be specified.
 		if opts.Username == "" && opts.UserID == "" {
 			return nil, ErrUsernameOrUserID{}
 		}
 
+		var user userReq
 		if opts.Username != "" {
 			// If Username is provided, UserID may not be provided.
 			if opts.UserID != "" {
@@ -166,25 +305,13 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 					return nil, ErrDomainIDOrDomainName{}
 				}
 
-				// Configure the request for Username and Password authentication with a DomainID.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{ID: &opts.DomainID},
-					},
-				}
+				// Username with a DomainID.
+				user = userReq{Name: &opts.Username, Domain: &domainReq{ID: &opts.DomainID}}
 			}
 
 			if opts.DomainName != "" {
-				// Configure the request for Username and Password authentication with a DomainName.
-				req.Auth.Identity.Password = &passwordReq{
-					User: userReq{
-						Name:     &opts.Username,
-						Password: opts.Password,
-						Domain:   &domainReq{Name: &opts.DomainName},
-					},
-				}
+				// Username with a DomainName.
+				user = userReq{Name: &opts.Username, Domain: &domainReq{Name: &opts.DomainName}}
 			}
 		}
 
@@ -197,16 +324,58 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 				return nil, ErrDomainNameWithUserID{}
 			}
 
-			// Configure the request for UserID and Password authentication.
-			req.Auth.Identity.Password = &passwordReq{
-				User: userReq{ID: &opts.UserID, Password: opts.Password},
+			user = userReq{ID: &opts.UserID}
+		}
+
+		if opts.Password != "" {
+			// Configure the request for Password authentication.
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "password")
+			user.Password = opts.Password
+			req.Auth.Identity.Password = &passwordReq{User: user}
+		}
+
+		if opts.Passcode != "" {
+			// Configure the request for TOTP authentication.
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "totp")
+			req.Auth.Identity.TOTP = &totpReq{
+				User: totpUserReq{
+					ID:       user.ID,
+					Name:     user.Name,
+					Domain:   user.Domain,
+					Passcode: opts.Passcode,
+				},
 			}
 		}
 	}
 
 	// Add a "scope" element if a Scope has been provided.
 	if scope != nil {
-		if scope.ProjectName != "" {
+		if scope.Unscoped {
+			// Unscoped provided. No other field may be provided.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" || scope.System || scope.TrustID != "" {
+				return nil, ErrScopeUnscopedAlone{}
+			}
+
+			req.Auth.Scope = "unscoped"
+		} else if scope.System {
+			// System provided. ProjectID, ProjectName, DomainID, DomainName and TrustID may not be provided.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" || scope.TrustID != "" {
+				return nil, ErrScopeSystemAlone{}
+			}
+
+			req.Auth.Scope = &scopeReq{
+				System: &systemReq{All: true},
+			}
+		} else if scope.TrustID != "" {
+			// TrustID provided. The trust determines the project, so no other field may be provided.
+			if scope.ProjectID != "" || scope.ProjectName != "" || scope.DomainID != "" || scope.DomainName != "" {
+				return nil, ErrScopeTrustAlone{}
+			}
+
+			req.Auth.Scope = &scopeReq{
+				Trust: &trustReq{ID: scope.TrustID},
+			}
+		} else if scope.ProjectName != "" {
 			// ProjectName provided: either DomainID or DomainName must also be supplied.
 			// ProjectID may not be supplied.
 			if scope.DomainID == "" && scope.DomainName == "" {
@@ -259,7 +428,10 @@ func (opts AuthOptions) ToTokenV3CreateMap(scope *Scope) (map[string]interface{}
 				Domain: &domainReq{ID: &scope.DomainID},
 			}
 		} else if scope.DomainName != "" {
-			return nil, ErrScopeDomainName{}
+			// DomainName
+			req.Auth.Scope = &scopeReq{
+				Domain: &domainReq{Name: &scope.DomainName},
+			}
 		} else {
 			return nil, ErrScopeEmpty{}
 		}
@@ -285,12 +457,31 @@ func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder, scopeOpts *Sc
 		r.Err = err
 		return
 	}
+
+	headers := map[string]string{"X-Auth-Token": ""}
+	if hb, ok := opts.(AuthOptionsHeadersBuilder); ok {
+		h, err := hb.ToTokenV3HeadersMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		for k, v := range h {
+			headers[k] = v
+		}
+	}
+
 	resp, err := c.Post(tokenURL(c), b, &r.Body, &gophercloud.RequestOpts{
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		MoreHeaders: headers,
 	})
 	if resp != nil {
 		r.Err = err
 		r.Header = resp.Header
+
+		// Keystone answers with a receipt when the methods provided are valid
+		// but don't satisfy the multi-factor authentication rules of the user.
+		if e, ok := err.(gophercloud.ErrDefault401); ok && resp.Header.Get(receiptHeader) != "" {
+			r.Err = newErrAdditionalAuthRequired(resp.Header.Get(receiptHeader), e)
+		}
 	}
 	return
 }
@@ -324,7 +515,8 @@ func Validate(c *gophercloud.ServiceClient, token string) (bool, error) {
 // Revoke immediately makes specified token invalid.
 func Revoke(c *gophercloud.ServiceClient, token string) (r RevokeResult) {
 	_, r.Err = c.Delete(tokenURL(c), &gophercloud.RequestOpts{
-		MoreHeaders: subjectTokenHeaders(c, token),
+		MoreHeaders:       subjectTokenHeaders(c, token),
+		DrainResponseBody: true,
 	})
 	return
 }
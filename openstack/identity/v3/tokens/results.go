@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
@@ -123,3 +124,56 @@ type Token struct {
 	// ExpiresAt is the timestamp at which this token will no longer be accepted.
 	ExpiresAt time.Time
 }
+
+// receiptHeader is the header auth receipts are sent and received in.
+const receiptHeader = "Openstack-Auth-Receipt"
+
+// Receipt is issued by Keystone when the authentication methods provided are
+// valid, but don't satisfy the multi-factor authentication rules of the user.
+type Receipt struct {
+	// ID is the receipt to send back, in AuthOptions.Receipt, along with the
+	// missing authentication methods.
+	ID string `json:"-"`
+
+	// Methods are the authentication methods already satisfied.
+	Methods []string `json:"methods"`
+
+	// UserID is the ID of the authenticated user.
+	UserID string `json:"-"`
+
+	// ExpiresAt is the time the receipt expires at.
+	ExpiresAt time.Time `json:"-"`
+
+	// RequiredAuthMethods are the sets of authentication methods that satisfy
+	// the rules of the user. Authentication succeeds once every method of one
+	// of the sets has been provided.
+	RequiredAuthMethods [][]string `json:"-"`
+}
+
+// newErrAdditionalAuthRequired builds an ErrAdditionalAuthRequired from the
+// receipt ID and the 401 response it was returned with.
+func newErrAdditionalAuthRequired(receiptID string, err gophercloud.ErrDefault401) ErrAdditionalAuthRequired {
+	var s struct {
+		Receipt struct {
+			Methods []string `json:"methods"`
+			User    struct {
+				ID string `json:"id"`
+			} `json:"user"`
+			ExpiresAt gophercloud.JSONISO8601 `json:"expires_at"`
+		} `json:"receipt"`
+		RequiredAuthMethods [][]string `json:"required_auth_methods"`
+	}
+	// The receipt ID is enough to continue, even if the body can't be read.
+	json.Unmarshal(err.Body, &s)
+
+	return ErrAdditionalAuthRequired{
+		Receipt: Receipt{
+			ID:                  receiptID,
+			Methods:             s.Receipt.Methods,
+			UserID:              s.Receipt.User.ID,
+			ExpiresAt:           time.Time(s.Receipt.ExpiresAt),
+			RequiredAuthMethods: s.RequiredAuthMethods,
+		},
+		Err: err,
+	}
+}
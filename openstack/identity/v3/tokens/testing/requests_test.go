@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -134,6 +135,70 @@ func TestCreateTokenID(t *testing.T) {
 	`)
 }
 
+func TestCreateAppCredID(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "swordfish"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "swordfish"
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateAppCredNameUsernameDomainName(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "ci",
+		ApplicationCredentialSecret: "swordfish",
+		Username:                    "fakeusername",
+		DomainName:                  "default",
+	}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"name": "ci",
+						"secret": "swordfish",
+						"user": {
+							"name": "fakeusername",
+							"domain": { "name": "default" }
+						}
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateAppCredNameUserID(t *testing.T) {
+	options := tokens.AuthOptions{
+		ApplicationCredentialName:   "ci",
+		ApplicationCredentialSecret: "swordfish",
+		UserID:                      "me",
+	}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["application_credential"],
+					"application_credential": {
+						"name": "ci",
+						"secret": "swordfish",
+						"user": { "id": "me" }
+					}
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateProjectIDScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{ProjectID: "123456"}
@@ -184,6 +249,77 @@ func TestCreateDomainIDScope(t *testing.T) {
 	`)
 }
 
+func TestCreateDomainNameScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
+	scope := &tokens.Scope{DomainName: "default"}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": {
+							"id": "fenris",
+							"password": "g0t0h311"
+						}
+					}
+				},
+				"scope": {
+					"domain": {
+						"name": "default"
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateSystemScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
+	scope := &tokens.Scope{System: true}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": {
+							"id": "fenris",
+							"password": "g0t0h311"
+						}
+					}
+				},
+				"scope": {
+					"system": {
+						"all": true
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateUnscoped(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
+	scope := &tokens.Scope{Unscoped: true}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": {
+							"id": "fenris",
+							"password": "g0t0h311"
+						}
+					}
+				},
+				"scope": "unscoped"
+			}
+		}
+	`)
+}
+
 func TestCreateProjectNameAndDomainIDScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
 	scope := &tokens.Scope{ProjectName: "world-domination", DomainID: "1000"}
@@ -373,16 +509,53 @@ func TestCreateFailureScopeProjectIDAndDomainNAme(t *testing.T) {
 	authTokenPostErr(t, options, scope, false, tokens.ErrScopeProjectIDAlone{})
 }
 
+func TestCreateTrustScope(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "fenris", Password: "g0t0h311"}
+	scope := &tokens.Scope{TrustID: "de0945a"}
+	authTokenPost(t, options, scope, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password"],
+					"password": {
+						"user": {
+							"id": "fenris",
+							"password": "g0t0h311"
+						}
+					}
+				},
+				"scope": {
+					"OS-TRUST:trust": {
+						"id": "de0945a"
+					}
+				}
+			}
+		}
+	`)
+}
+
 func TestCreateFailureScopeDomainIDAndDomainName(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
 	scope := &tokens.Scope{DomainID: "toomuch", DomainName: "notneeded"}
 	authTokenPostErr(t, options, scope, false, tokens.ErrScopeDomainIDOrDomainName{})
 }
 
-func TestCreateFailureScopeDomainNameAlone(t *testing.T) {
+func TestCreateFailureScopeSystemAndProjectID(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
-	scope := &tokens.Scope{DomainName: "notenough"}
-	authTokenPostErr(t, options, scope, false, tokens.ErrScopeDomainName{})
+	scope := &tokens.Scope{System: true, ProjectID: "toomuch"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeSystemAlone{})
+}
+
+func TestCreateFailureScopeTrustAndProjectID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{TrustID: "de0945a", ProjectID: "toomuch"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeTrustAlone{})
+}
+
+func TestCreateFailureScopeUnscopedAndDomainID(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "myself", Password: "swordfish"}
+	scope := &tokens.Scope{Unscoped: true, DomainID: "toomuch"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeUnscopedAlone{})
 }
 
 func TestCreateFailureEmptyScope(t *testing.T) {
@@ -391,6 +564,22 @@ func TestCreateFailureEmptyScope(t *testing.T) {
 	authTokenPostErr(t, options, scope, false, tokens.ErrScopeEmpty{})
 }
 
+func TestCreateFailureAppCredMissingSecret(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef"}
+	authTokenPostErr(t, options, nil, false, tokens.ErrAppCredMissingSecret{})
+}
+
+func TestCreateFailureAppCredNameMissingUser(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialName: "ci", ApplicationCredentialSecret: "swordfish"}
+	authTokenPostErr(t, options, nil, false, tokens.ErrUsernameOrUserID{})
+}
+
+func TestCreateFailureAppCredScope(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "swordfish"}
+	scope := &tokens.Scope{ProjectID: "123456"}
+	authTokenPostErr(t, options, scope, false, tokens.ErrScopeWithAppCred{})
+}
+
 func TestGetRequest(t *testing.T) {
 	testhelper.SetupHTTP()
 	defer testhelper.TeardownHTTP()
@@ -507,3 +696,116 @@ func TestRevokeRequestError(t *testing.T) {
 		t.Errorf("Missing expected error from Revoke")
 	}
 }
+
+func TestCreatePasswordAndTOTP(t *testing.T) {
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!", Passcode: "123456"}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"methods": ["password", "totp"],
+					"password": {
+						"user": { "id": "me", "password": "squirrel!" }
+					},
+					"totp": {
+						"user": { "id": "me", "passcode": "123456" }
+					}
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateTOTPWithUsernameAndReceipt(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "Openstack-Auth-Receipt", "receipt-123")
+		testhelper.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["totp"],
+						"totp": {
+							"user": {
+								"name": "fakey",
+								"domain": { "name": "fakey-domain" },
+								"passcode": "123456"
+							}
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "mfa-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2014-10-02T13:45:00.000000Z" } }`)
+	})
+
+	options := tokens.AuthOptions{
+		Username:   "fakey",
+		DomainName: "fakey-domain",
+		Passcode:   "123456",
+		Receipt:    "receipt-123",
+	}
+	token, err := tokens.Create(&client, options, nil).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "mfa-token", token.ID)
+}
+
+func TestCreateAdditionalAuthRequired(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+
+		w.Header().Add("Openstack-Auth-Receipt", "receipt-123")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `
+			{
+				"receipt": {
+					"methods": ["password"],
+					"user": { "id": "me", "name": "fakey", "domain": { "id": "default" } },
+					"expires_at": "2014-10-02T13:50:00.000000Z",
+					"issued_at": "2014-10-02T13:45:00.000000Z"
+				},
+				"required_auth_methods": [["password", "totp"]]
+			}
+		`)
+	})
+
+	options := tokens.AuthOptions{UserID: "me", Password: "squirrel!"}
+	_, err := tokens.Create(&client, options, nil).ExtractToken()
+
+	var receiptErr tokens.ErrAdditionalAuthRequired
+	if !errors.As(err, &receiptErr) {
+		t.Fatalf("Expected an ErrAdditionalAuthRequired, got %#v", err)
+	}
+	testhelper.CheckDeepEquals(t, tokens.Receipt{
+		ID:                  "receipt-123",
+		Methods:             []string{"password"},
+		UserID:              "me",
+		ExpiresAt:           time.Date(2014, 10, 2, 13, 50, 0, 0, time.UTC),
+		RequiredAuthMethods: [][]string{{"password", "totp"}},
+	}, receiptErr.Receipt)
+
+	var unauthorized gophercloud.ErrDefault401
+	if !errors.As(err, &unauthorized) {
+		t.Errorf("Expected the error to wrap an ErrDefault401, got %#v", err)
+	}
+}
@@ -0,0 +1,409 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of User results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/users"
+    },
+    "users": [
+        {
+            "domain_id": "default",
+            "enabled": true,
+            "id": "2844b2a08be147a08ef58317d6471f1f",
+            "links": {
+                "self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f"
+            },
+            "name": "glance",
+            "password_expires_at": null,
+            "description": "some description",
+            "options": {}
+        },
+        {
+            "default_project_id": "263fd9",
+            "domain_id": "1789d1",
+            "enabled": true,
+            "id": "9fe1d3",
+            "links": {
+                "self": "https://example.com/identity/v3/users/9fe1d3"
+            },
+            "name": "jsmith",
+            "password_expires_at": "2016-11-06T15:32:17.000000",
+            "options": {
+                "ignore_password_expiry": true
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "user": {
+        "default_project_id": "263fd9",
+        "domain_id": "1789d1",
+        "enabled": true,
+        "id": "9fe1d3",
+        "links": {
+            "self": "https://example.com/identity/v3/users/9fe1d3"
+        },
+        "name": "jsmith",
+        "password_expires_at": "2016-11-06T15:32:17.000000",
+        "options": {
+            "ignore_password_expiry": true
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "user": {
+        "default_project_id": "263fd9",
+        "domain_id": "1789d1",
+        "enabled": true,
+        "name": "jsmith",
+        "password": "secretsecret",
+        "options": {
+            "ignore_password_expiry": true
+        }
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "user": {
+        "enabled": false,
+        "description": "Staging User"
+    }
+}
+`
+
+// UpdateOutput provides an update result.
+const UpdateOutput = `
+{
+    "user": {
+        "default_project_id": "263fd9",
+        "domain_id": "1789d1",
+        "enabled": false,
+        "id": "9fe1d3",
+        "links": {
+            "self": "https://example.com/identity/v3/users/9fe1d3"
+        },
+        "name": "jsmith",
+        "password_expires_at": "2016-11-06T15:32:17.000000",
+        "description": "Staging User",
+        "options": {
+            "ignore_password_expiry": true
+        }
+    }
+}
+`
+
+// ChangePasswordRequest provides the input to a ChangePassword request.
+const ChangePasswordRequest = `
+{
+    "user": {
+        "password": "new_secretsecret",
+        "original_password": "secretsecret"
+    }
+}
+`
+
+// ListGroupsOutput provides a ListGroups result.
+const ListGroupsOutput = `
+{
+    "groups": [
+        {
+            "description": "Developers cleared for work on all general projects",
+            "domain_id": "1789d1",
+            "id": "ea167b",
+            "links": {
+                "self": "https://example.com/identity/v3/groups/ea167b"
+            },
+            "name": "Developers"
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/users/9fe1d3/groups",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// ListProjectsOutput provides a ListProjects result.
+const ListProjectsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://localhost:5000/identity/v3/users/9fe1d3/projects"
+    },
+    "projects": [
+        {
+            "description": "my first project",
+            "domain_id": "11111",
+            "enabled": true,
+            "id": "abcde",
+            "links": {
+                "self": "http://localhost:5000/identity/v3/projects/abcde"
+            },
+            "name": "project 1",
+            "parent_id": "11111"
+        }
+    ]
+}
+`
+
+// FirstUser is the first user in the List request.
+var FirstUser = users.User{
+	DomainID: "default",
+	Enabled:  true,
+	ID:       "2844b2a08be147a08ef58317d6471f1f",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/users/2844b2a08be147a08ef58317d6471f1f",
+	},
+	Name:        "glance",
+	Description: "some description",
+	Options:     map[string]interface{}{},
+}
+
+// SecondUserPasswordExpiresAt is the password expiry of SecondUser.
+var SecondUserPasswordExpiresAt, _ = time.Parse("2006-01-02T15:04:05.000000", "2016-11-06T15:32:17.000000")
+
+// SecondUser is the second user in the List request.
+var SecondUser = users.User{
+	DefaultProjectID: "263fd9",
+	DomainID:         "1789d1",
+	Enabled:          true,
+	ID:               "9fe1d3",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/users/9fe1d3",
+	},
+	Name:              "jsmith",
+	PasswordExpiresAt: SecondUserPasswordExpiresAt,
+	Options: map[string]interface{}{
+		"ignore_password_expiry": true,
+	},
+}
+
+// SecondUserUpdated is how SecondUser should look after an Update.
+var SecondUserUpdated = users.User{
+	DefaultProjectID: "263fd9",
+	DomainID:         "1789d1",
+	Enabled:          false,
+	ID:               "9fe1d3",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/users/9fe1d3",
+	},
+	Name:              "jsmith",
+	PasswordExpiresAt: SecondUserPasswordExpiresAt,
+	Description:       "Staging User",
+	Options: map[string]interface{}{
+		"ignore_password_expiry": true,
+	},
+}
+
+// ExpectedUsersSlice is the slice of users expected to be returned from ListOutput.
+var ExpectedUsersSlice = []users.User{FirstUser, SecondUser}
+
+// ExpectedGroupsSlice is the slice of groups expected to be returned from
+// ListGroupsOutput.
+var ExpectedGroupsSlice = []groups.Group{
+	{
+		Description: "Developers cleared for work on all general projects",
+		DomainID:    "1789d1",
+		ID:          "ea167b",
+		Links: map[string]interface{}{
+			"self": "https://example.com/identity/v3/groups/ea167b",
+		},
+		Name: "Developers",
+	},
+}
+
+// ExpectedProjectsSlice is the slice of projects expected to be returned
+// from ListProjectsOutput.
+var ExpectedProjectsSlice = []projects.Project{
+	{
+		Description: "my first project",
+		DomainID:    "11111",
+		Enabled:     true,
+		ID:          "abcde",
+		Links: map[string]interface{}{
+			"self": "http://localhost:5000/identity/v3/projects/abcde",
+		},
+		Name:     "project 1",
+		ParentID: "11111",
+	},
+}
+
+// HandleListUsersSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that responds with a list of two users.
+func HandleListUsersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that responds with a single user.
+func HandleGetUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that tests user creation.
+func HandleCreateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that tests user update.
+func HandleUpdateUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleChangeUserPasswordSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/password` on the test handler mux that tests change user
+// password.
+func HandleChangeUserPasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ChangePasswordRequest)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleDeleteUserSuccessfully creates an HTTP handler at `/users` on the
+// test handler mux that tests user deletion.
+func HandleDeleteUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListUserGroupsSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/groups` on the test handler mux that responds with a list of
+// one group.
+func HandleListUserGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListGroupsOutput)
+	})
+}
+
+// HandleListUserProjectsSuccessfully creates an HTTP handler at
+// `/users/9fe1d3/projects` on the test handler mux that responds with a list
+// of one project.
+func HandleListUserProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/9fe1d3/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListProjectsOutput)
+	})
+}
+
+// HandleListInGroupSuccessfully creates an HTTP handler at
+// `/groups/ea167b/users` on the test handler mux that responds with a list of
+// two users.
+func HandleListInGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/ea167b/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGroupMembershipSuccessfully creates an HTTP handler at
+// `/groups/ea167b/users/9fe1d3` on the test handler mux that tests adding,
+// checking and removing a group member. The user is a member of the group
+// until it is removed.
+func HandleGroupMembershipSuccessfully(t *testing.T) {
+	member := false
+	th.Mux.HandleFunc("/groups/ea167b/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			member = true
+		case "DELETE":
+			member = false
+		case "HEAD":
+			if !member {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,202 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/groups"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListUsers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUsersSuccessfully(t)
+
+	count := 0
+	err := users.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := users.ExtractUsers(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestListUsersAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUsersSuccessfully(t)
+
+	allPages, err := users.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := users.ExtractUsers(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
+	th.AssertEquals(t, true, actual[0].PasswordExpiresAt.IsZero())
+}
+
+func TestListUsersQuery(t *testing.T) {
+	listOpts := users.ListOpts{
+		Enabled:           gophercloud.Disabled,
+		PasswordExpiresAt: "lt:2016-12-08T22:02:00Z",
+	}
+
+	query, err := listOpts.ToUserListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?enabled=false&password_expires_at=lt%3A2016-12-08T22%3A02%3A00Z", query)
+}
+
+func TestGetUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetUserSuccessfully(t)
+
+	actual, err := users.Get(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUser, *actual)
+}
+
+func TestCreateUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateUserSuccessfully(t)
+
+	createOpts := users.CreateOpts{
+		Name:             "jsmith",
+		DomainID:         "1789d1",
+		Enabled:          gophercloud.Enabled,
+		Password:         "secretsecret",
+		DefaultProjectID: "263fd9",
+		Options: map[users.Option]interface{}{
+			users.IgnorePasswordExpiry: true,
+		},
+	}
+
+	actual, err := users.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUser, *actual)
+}
+
+func TestUpdateUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateUserSuccessfully(t)
+
+	description := "Staging User"
+	updateOpts := users.UpdateOpts{
+		Enabled:     gophercloud.Disabled,
+		Description: &description,
+	}
+
+	actual, err := users.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SecondUserUpdated, *actual)
+}
+
+func TestChangeUserPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChangeUserPasswordSuccessfully(t)
+
+	changePasswordOpts := users.ChangePasswordOpts{
+		OriginalPassword: "secretsecret",
+		Password:         "new_secretsecret",
+	}
+
+	res := users.ChangePassword(client.ServiceClient(), "9fe1d3", changePasswordOpts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestChangeUserPasswordMissingOriginal(t *testing.T) {
+	changePasswordOpts := users.ChangePasswordOpts{
+		Password: "new_secretsecret",
+	}
+
+	res := users.ChangePassword(client.ServiceClient(), "9fe1d3", changePasswordOpts)
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteUserSuccessfully(t)
+
+	res := users.Delete(client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListUserGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUserGroupsSuccessfully(t)
+
+	allPages, err := users.ListGroups(client.ServiceClient(), "9fe1d3").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := groups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedGroupsSlice, actual)
+}
+
+func TestListUserProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListUserProjectsSuccessfully(t)
+
+	allPages, err := users.ListProjects(client.ServiceClient(), "9fe1d3").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectsSlice, actual)
+}
+
+func TestListInGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListInGroupSuccessfully(t)
+
+	listOpts := users.ListOpts{
+		Enabled: gophercloud.Enabled,
+	}
+
+	allPages, err := users.ListInGroup(client.ServiceClient(), "ea167b", listOpts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := users.ExtractUsers(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
+}
+
+func TestGroupMembership(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupMembershipSuccessfully(t)
+
+	ok, err := users.IsMemberOfGroup(client.ServiceClient(), "ea167b", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, ok)
+
+	err = users.AddToGroup(client.ServiceClient(), "ea167b", "9fe1d3").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err = users.IsMemberOfGroup(client.ServiceClient(), "ea167b", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, ok)
+
+	err = users.RemoveFromGroup(client.ServiceClient(), "ea167b", "9fe1d3").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err = users.IsMemberOfGroup(client.ServiceClient(), "ea167b", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, ok)
+}
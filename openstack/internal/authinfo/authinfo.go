@@ -0,0 +1,221 @@
+// Package authinfo holds the credentials of a cloud and the way they turn into
+// authentication options. It's shared by openstack.AuthOptionsFromEnv and the
+// clientconfig package, so that the OS_* environment variables and clouds.yaml
+// are read with the same semantics.
+package authinfo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// AuthInfo holds the credentials of a cloud.
+type AuthInfo struct {
+	AuthURL string `yaml:"auth_url"`
+
+	Token string `yaml:"token"`
+
+	Username string `yaml:"username"`
+	UserID   string `yaml:"user_id"`
+	Password string `yaml:"password"`
+
+	ProjectName string `yaml:"project_name"`
+	ProjectID   string `yaml:"project_id"`
+
+	// TenantName and TenantID are the legacy names of ProjectName and
+	// ProjectID.
+	TenantName string `yaml:"tenant_name"`
+	TenantID   string `yaml:"tenant_id"`
+
+	UserDomainName    string `yaml:"user_domain_name"`
+	UserDomainID      string `yaml:"user_domain_id"`
+	ProjectDomainName string `yaml:"project_domain_name"`
+	ProjectDomainID   string `yaml:"project_domain_id"`
+
+	// DomainName and DomainID scope the token to a domain, and act as the
+	// domain of the user and the project when those aren't set.
+	DomainName string `yaml:"domain_name"`
+	DomainID   string `yaml:"domain_id"`
+
+	// DefaultDomain is the ID of the domain used when no other domain is set.
+	DefaultDomain string `yaml:"default_domain"`
+
+	// SystemScope scopes the token to the system when it's set to "all".
+	SystemScope string `yaml:"system_scope"`
+
+	// ApplicationCredentialID, or ApplicationCredentialName along with the
+	// user, identify an application credential, and
+	// ApplicationCredentialSecret is its secret.
+	ApplicationCredentialID     string `yaml:"application_credential_id"`
+	ApplicationCredentialName   string `yaml:"application_credential_name"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+}
+
+// FromEnv reads the credentials from the OS_* environment variables, as set by
+// an openrc file. Legacy names, such as OS_TENANT_NAME, are read when the
+// current ones aren't set.
+func FromEnv() AuthInfo {
+	return AuthInfo{
+		AuthURL:                     os.Getenv("OS_AUTH_URL"),
+		Token:                       Getenv("OS_TOKEN", "OS_AUTH_TOKEN"),
+		Username:                    os.Getenv("OS_USERNAME"),
+		UserID:                      Getenv("OS_USER_ID", "OS_USERID"),
+		Password:                    os.Getenv("OS_PASSWORD"),
+		ProjectName:                 os.Getenv("OS_PROJECT_NAME"),
+		ProjectID:                   os.Getenv("OS_PROJECT_ID"),
+		TenantName:                  os.Getenv("OS_TENANT_NAME"),
+		TenantID:                    os.Getenv("OS_TENANT_ID"),
+		UserDomainName:              os.Getenv("OS_USER_DOMAIN_NAME"),
+		UserDomainID:                os.Getenv("OS_USER_DOMAIN_ID"),
+		ProjectDomainName:           os.Getenv("OS_PROJECT_DOMAIN_NAME"),
+		ProjectDomainID:             os.Getenv("OS_PROJECT_DOMAIN_ID"),
+		DomainName:                  os.Getenv("OS_DOMAIN_NAME"),
+		DomainID:                    os.Getenv("OS_DOMAIN_ID"),
+		DefaultDomain:               os.Getenv("OS_DEFAULT_DOMAIN"),
+		SystemScope:                 os.Getenv("OS_SYSTEM_SCOPE"),
+		ApplicationCredentialID:     os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+		ApplicationCredentialName:   os.Getenv("OS_APPLICATION_CREDENTIAL_NAME"),
+		ApplicationCredentialSecret: os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
+	}
+}
+
+// AuthTypeFromEnv returns the authentication method set by OS_AUTH_TYPE or, if
+// it isn't set, the one inferred from the credentials: an application
+// credential if its ID or name is set, then a token if it's set and the
+// password isn't, and a password otherwise.
+func (a AuthInfo) AuthTypeFromEnv() string {
+	if authType := os.Getenv("OS_AUTH_TYPE"); authType != "" {
+		return authType
+	}
+
+	switch {
+	case a.ApplicationCredentialID != "" || a.ApplicationCredentialName != "":
+		return "v3applicationcredential"
+	case a.Token != "" && a.Password == "":
+		return "token"
+	}
+	return ""
+}
+
+// ErrUnsupportedAuthType is the error when an authentication method isn't
+// supported.
+type ErrUnsupportedAuthType struct {
+	gophercloud.BaseError
+	AuthType string
+}
+
+func (e ErrUnsupportedAuthType) Error() string {
+	return fmt.Sprintf("Unsupported auth_type: %s", e.AuthType)
+}
+
+// AuthOptions returns the options to authenticate with the credentials, using
+// the authentication method authType. An empty authType stands for a password.
+func (a AuthInfo) AuthOptions(authType string) (gophercloud.AuthOptions, error) {
+	if a.AuthURL == "" {
+		return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "authURL"}
+	}
+
+	ao := gophercloud.AuthOptions{
+		IdentityEndpoint: a.AuthURL,
+	}
+
+	switch authType {
+	case "token", "v2token", "v3token":
+		if a.Token == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "token"}
+		}
+		ao.TokenID = a.Token
+	case "", "password", "v2password", "v3password":
+		if a.Username == "" && a.UserID == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "username"}
+		}
+		if a.Password == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "password"}
+		}
+		ao.Username = a.Username
+		ao.UserID = a.UserID
+		ao.Password = a.Password
+		a.setUserDomain(&ao)
+	case "v3applicationcredential":
+		if a.ApplicationCredentialID == "" && a.ApplicationCredentialName == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "applicationCredentialID"}
+		}
+		if a.ApplicationCredentialSecret == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "applicationCredentialSecret"}
+		}
+		ao.ApplicationCredentialID = a.ApplicationCredentialID
+		ao.ApplicationCredentialName = a.ApplicationCredentialName
+		ao.ApplicationCredentialSecret = a.ApplicationCredentialSecret
+
+		// The owner identifies an application credential along with its name.
+		if ao.ApplicationCredentialID == "" {
+			ao.Username = a.Username
+			ao.UserID = a.UserID
+			a.setUserDomain(&ao)
+		}
+
+		// An application credential is scoped to its own project.
+		return ao, nil
+	default:
+		return gophercloud.AuthOptions{}, ErrUnsupportedAuthType{AuthType: authType}
+	}
+
+	ao.TenantID = firstOf(a.ProjectID, a.TenantID)
+	ao.TenantName = firstOf(a.ProjectName, a.TenantName)
+
+	switch {
+	case a.SystemScope != "":
+		ao.Scope = &gophercloud.AuthScope{System: true}
+	case ao.TenantID != "":
+	case ao.TenantName != "":
+		// A project name is only unique within its domain.
+		if a.ProjectDomainID != "" || a.ProjectDomainName != "" {
+			ao.ProjectDomainID = a.ProjectDomainID
+			ao.ProjectDomainName = a.ProjectDomainName
+		} else if a.DomainID != "" || a.DomainName != "" {
+			ao.ProjectDomainID = a.DomainID
+			ao.ProjectDomainName = a.DomainName
+		} else {
+			ao.ProjectDomainID = a.DefaultDomain
+		}
+	case a.DomainID != "" || a.DomainName != "":
+		// Without a project, the domain is the scope of the token.
+		ao.Scope = &gophercloud.AuthScope{
+			DomainID:   a.DomainID,
+			DomainName: a.DomainName,
+		}
+	}
+
+	return ao, nil
+}
+
+// setUserDomain sets the domain of the user, which identifies a user by name.
+func (a AuthInfo) setUserDomain(ao *gophercloud.AuthOptions) {
+	ao.DomainID = firstOf(a.UserDomainID, a.DomainID)
+	ao.DomainName = firstOf(a.UserDomainName, a.DomainName)
+	if ao.DomainID == "" && ao.DomainName == "" && ao.UserID == "" {
+		ao.DomainID = a.DefaultDomain
+	}
+}
+
+// Getenv returns the value of the first of the environment variables that is
+// set.
+func Getenv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
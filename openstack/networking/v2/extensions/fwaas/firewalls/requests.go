@@ -135,6 +135,8 @@ func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r
 
 // Delete will permanently delete a particular firewall based on its unique ID.
 func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	_, r.Err = c.Delete(resourceURL(c, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
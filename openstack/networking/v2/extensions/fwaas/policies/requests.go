@@ -134,7 +134,9 @@ func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r
 
 // Delete will permanently delete a particular firewall policy based on its unique ID.
 func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	_, r.Err = c.Delete(resourceURL(c, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
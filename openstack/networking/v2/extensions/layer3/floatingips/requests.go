@@ -140,6 +140,8 @@ func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r
 // ensure this is what you want - you can also disassociate the IP from existing
 // internal ports.
 func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	_, r.Err = c.Delete(resourceURL(c, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -146,7 +146,9 @@ func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r
 
 // Delete will permanently delete a particular pool based on its unique ID.
 func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	_, r.Err = c.Delete(resourceURL(c, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -165,6 +167,8 @@ func AssociateMonitor(c *gophercloud.ServiceClient, poolID, monitorID string) (r
 // pool. When dissociation is successful, the health monitor will no longer
 // check for the health of the members of the pool.
 func DisassociateMonitor(c *gophercloud.ServiceClient, poolID, monitorID string) (r AssociateResult) {
-	_, r.Err = c.Delete(disassociateURL(c, poolID, monitorID), nil)
+	_, r.Err = c.Delete(disassociateURL(c, poolID, monitorID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
@@ -176,7 +176,9 @@ func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r
 
 // Delete will permanently delete a particular pool based on its unique ID.
 func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	_, r.Err = c.Delete(resourceURL(c, id), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -329,6 +331,8 @@ func UpdateMember(c *gophercloud.ServiceClient, poolID string, memberID string,
 
 // DisassociateMember will remove and disassociate a Member from a particular Pool.
 func DeleteMember(c *gophercloud.ServiceClient, poolID string, memberID string) (r DeleteMemberResult) {
-	_, r.Err = c.Delete(memberResourceURL(c, poolID, memberID), nil)
+	_, r.Err = c.Delete(memberResourceURL(c, poolID, memberID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
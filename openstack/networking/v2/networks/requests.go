@@ -132,7 +132,9 @@ func Update(c *gophercloud.ServiceClient, networkID string, opts UpdateOptsBuild
 
 // Delete accepts a unique ID and deletes the network associated with it.
 func Delete(c *gophercloud.ServiceClient, networkID string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, networkID), nil)
+	_, r.Err = c.Delete(deleteURL(c, networkID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
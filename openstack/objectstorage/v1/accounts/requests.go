@@ -89,8 +89,9 @@ func Update(c *gophercloud.ServiceClient, opts UpdateOptsBuilder) (r UpdateResul
 		}
 	}
 	resp, err := c.Request("POST", updateURL(c), &gophercloud.RequestOpts{
-		MoreHeaders: h,
-		OkCodes:     []int{201, 202, 204},
+		MoreHeaders:       h,
+		OkCodes:           []int{201, 202, 204},
+		DrainResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
@@ -102,8 +102,9 @@ func Create(c *gophercloud.ServiceClient, containerName string, opts CreateOptsB
 		}
 	}
 	resp, err := c.Request("PUT", createURL(c, containerName), &gophercloud.RequestOpts{
-		MoreHeaders: h,
-		OkCodes:     []int{201, 202, 204},
+		MoreHeaders:       h,
+		OkCodes:           []int{201, 202, 204},
+		DrainResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
@@ -114,7 +115,9 @@ func Create(c *gophercloud.ServiceClient, containerName string, opts CreateOptsB
 
 // Delete is a function that deletes a container.
 func Delete(c *gophercloud.ServiceClient, containerName string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, containerName), nil)
+	_, r.Err = c.Delete(deleteURL(c, containerName), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -166,8 +169,9 @@ func Update(c *gophercloud.ServiceClient, containerName string, opts UpdateOptsB
 		}
 	}
 	resp, err := c.Request("POST", updateURL(c, containerName), &gophercloud.RequestOpts{
-		MoreHeaders: h,
-		OkCodes:     []int{201, 202, 204},
+		MoreHeaders:       h,
+		OkCodes:           []int{201, 202, 204},
+		DrainResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
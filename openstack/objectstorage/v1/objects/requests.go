@@ -210,8 +210,9 @@ func Create(c *gophercloud.ServiceClient, containerName, objectName string, opts
 	}
 
 	resp, err := c.Put(url, nil, nil, &gophercloud.RequestOpts{
-		RawBody:     b,
-		MoreHeaders: h,
+		RawBody:           b,
+		MoreHeaders:       h,
+		DrainResponseBody: true,
 	})
 	r.Err = err
 	if resp != nil {
@@ -263,8 +264,9 @@ func Copy(c *gophercloud.ServiceClient, containerName, objectName string, opts C
 
 	url := copyURL(c, containerName, objectName)
 	resp, err := c.Request("COPY", url, &gophercloud.RequestOpts{
-		MoreHeaders: h,
-		OkCodes:     []int{201},
+		MoreHeaders:       h,
+		OkCodes:           []int{201},
+		DrainResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
@@ -301,7 +303,9 @@ func Delete(c *gophercloud.ServiceClient, containerName, objectName string, opts
 		}
 		url += query
 	}
-	resp, err := c.Delete(url, nil)
+	resp, err := c.Delete(url, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	if resp != nil {
 		r.Header = resp.Header
 	}
@@ -395,7 +399,8 @@ func Update(c *gophercloud.ServiceClient, containerName, objectName string, opts
 	}
 	url := updateURL(c, containerName, objectName)
 	resp, err := c.Post(url, nil, nil, &gophercloud.RequestOpts{
-		MoreHeaders: h,
+		MoreHeaders:       h,
+		DrainResponseBody: true,
 	})
 	if resp != nil {
 		r.Header = resp.Header
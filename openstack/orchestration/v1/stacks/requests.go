@@ -336,13 +336,17 @@ func Update(c *gophercloud.ServiceClient, stackName, stackID string, opts Update
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateURL(c, stackName, stackID), b, nil, nil)
+	_, r.Err = c.Put(updateURL(c, stackName, stackID), b, nil, &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
 // Delete deletes a stack based on the stack name and stack ID.
 func Delete(c *gophercloud.ServiceClient, stackName, stackID string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, stackName, stackID), nil)
+	_, r.Err = c.Delete(deleteURL(c, stackName, stackID), &gophercloud.RequestOpts{
+		DrainResponseBody: true,
+	})
 	return
 }
 
@@ -433,8 +437,9 @@ func Preview(c *gophercloud.ServiceClient, opts PreviewOptsBuilder) (r PreviewRe
 // resources intact, and returns data describing the stack and its resources.
 func Abandon(c *gophercloud.ServiceClient, stackName, stackID string) (r AbandonResult) {
 	_, r.Err = c.Delete(abandonURL(c, stackName, stackID), &gophercloud.RequestOpts{
-		JSONResponse: &r.Body,
-		OkCodes:      []int{200},
+		JSONResponse:      &r.Body,
+		OkCodes:           []int{200},
+		DrainResponseBody: true,
 	})
 	return
 }
@@ -0,0 +1,42 @@
+package stacks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// WaitForStatusContext polls a stack until it transitions to the specified
+// status, such as CREATE_COMPLETE, and returns it. If the stack reaches a
+// failure status, such as CREATE_FAILED, instead, it returns a
+// gophercloud.ErrResourceFailed holding the reason for the failure. If ctx is
+// done first, it returns a gophercloud.ErrWaitTimeout holding the last stack
+// observed.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, stackName, stackID, status string, opts gophercloud.WaitOpts) (*RetrievedStack, error) {
+	r, err := gophercloud.Wait(ctx, opts, func(ctx context.Context) (interface{}, bool, error) {
+		current, err := Get(c.WithContext(ctx), stackName, stackID).Extract()
+		if err != nil {
+			return nil, false, err
+		}
+
+		if current.Status == status {
+			return current, true, nil
+		}
+
+		if strings.HasSuffix(current.Status, "_FAILED") {
+			return current, false, gophercloud.ErrResourceFailed{
+				ResourceType: "stack",
+				ID:           stackID,
+				Status:       current.Status,
+				Detail:       current.StatusReason,
+				Resource:     current,
+			}
+		}
+
+		return current, false, nil
+	})
+
+	stack, _ := r.(*RetrievedStack)
+	return stack, err
+}
@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	for k, v := range env {
+		th.AssertNoErr(t, os.Setenv(k, v))
+	}
+	return func() {
+		for k := range env {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+func TestAuthOptionsFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":         "https://identity.example.com/v3",
+		"OS_USERNAME":         "me",
+		"OS_PASSWORD":         "swordfish",
+		"OS_PROJECT_ID":       "1234",
+		"OS_TENANT_ID":        "5678",
+		"OS_USER_DOMAIN_NAME": "Default",
+		"OS_TOKEN":            "abcdef",
+	})()
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "me",
+		Password:         "swordfish",
+		DomainName:       "Default",
+		TenantID:         "1234",
+	}, ao)
+}
+
+func TestAuthOptionsFromEnvToken(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":     "https://identity.example.com/v3",
+		"OS_TOKEN":        "abcdef",
+		"OS_PROJECT_NAME": "myproject",
+	})()
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		TokenID:          "abcdef",
+		TenantName:       "myproject",
+	}, ao)
+}
+
+func TestAuthOptionsFromEnvMissingPassword(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL": "https://identity.example.com/v3",
+		"OS_USERNAME": "me",
+	})()
+
+	_, err := openstack.AuthOptionsFromEnv()
+	th.AssertDeepEquals(t, gophercloud.ErrMissingInput{Argument: "password"}, err)
+}
+
+func TestAuthOptionsFromEnvDomain(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":       "https://identity.example.com/v3",
+		"OS_USERNAME":       "admin",
+		"OS_PASSWORD":       "swordfish",
+		"OS_DOMAIN_NAME":    "engineering",
+		"OS_DEFAULT_DOMAIN": "default",
+	})()
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "admin",
+		Password:         "swordfish",
+		DomainName:       "engineering",
+		Scope:            &gophercloud.AuthScope{DomainName: "engineering"},
+	}, ao)
+}
+
+func TestAuthOptionsFromEnvDefaultDomain(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":       "https://identity.example.com/v3",
+		"OS_USERNAME":       "me",
+		"OS_PASSWORD":       "swordfish",
+		"OS_PROJECT_NAME":   "myproject",
+		"OS_DEFAULT_DOMAIN": "default",
+	})()
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://identity.example.com/v3",
+		Username:         "me",
+		Password:         "swordfish",
+		DomainID:         "default",
+		TenantName:       "myproject",
+		ProjectDomainID:  "default",
+	}, ao)
+}
+
+func TestAuthOptionsFromEnvUnsupportedAuthType(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		"OS_AUTH_URL":  "https://identity.example.com/v3",
+		"OS_AUTH_TYPE": "v3oidcpassword",
+	})()
+
+	_, err := openstack.AuthOptionsFromEnv()
+	th.AssertDeepEquals(t, gophercloud.ErrInvalidInput{
+		ErrMissingInput: gophercloud.ErrMissingInput{Argument: "authType"},
+		Value:           "v3oidcpassword",
+	}, err)
+}
@@ -1,12 +1,17 @@
 package testing
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/extensions/federation"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -56,6 +61,7 @@ func TestAuthenticatedClientV3(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, ID, client.TokenID)
+	th.CheckEquals(t, time.Date(2013, 2, 2, 18, 30, 59, 0, time.UTC), client.TokenExpiresAt())
 }
 
 func TestAuthenticatedClientV2(t *testing.T) {
@@ -159,4 +165,496 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
+	th.CheckEquals(t, time.Date(2014, 10, 1, 10, 0, 0, 0, time.UTC), client.TokenExpiresAt())
+}
+
+func TestAuthenticateV3ApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	requests := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["application_credential"],
+						"application_credential": {
+							"id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+							"secret": "swordfish"
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("token-%d", requests))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint:            th.Endpoint(),
+		ApplicationCredentialID:     "c4859fb437df4b87a51a8f5adcfb0bc7",
+		ApplicationCredentialSecret: "swordfish",
+		TenantName:                  "ignored",
+		AllowReauth:                 true,
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token-1", client.TokenID)
+
+	// Re-authentication uses the application credential again.
+	th.AssertNoErr(t, client.ReauthFunc(context.Background()))
+	th.CheckEquals(t, "token-2", client.TokenID)
+	th.CheckEquals(t, 2, requests)
+}
+
+func TestAuthenticateV3ApplicationCredentialWithScope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint:            th.Endpoint(),
+		ApplicationCredentialID:     "c4859fb437df4b87a51a8f5adcfb0bc7",
+		ApplicationCredentialSecret: "swordfish",
+		Scope:                       &gophercloud.AuthScope{ProjectID: "1234"},
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertDeepEquals(t, tokens3.ErrScopeWithAppCred{}, err)
+}
+
+func TestReauthV3UpdatesEndpointLocator(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	requests := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		requests++
+
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("token-%d", requests))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"token": {
+				"expires_at": "2013-02-02T18:30:59.000000Z",
+				"catalog": [
+					{
+						"type": "compute",
+						"endpoints": [
+							{ "interface": "public", "region": "RegionOne", "url": "https://compute-%d.example.com/" }
+						]
+					}
+				]
+			}
+		}`, requests)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(client, gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "me",
+		Password:         "secret",
+		AllowReauth:      true,
+	}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+
+	eo := gophercloud.EndpointOpts{Type: "compute", Region: "RegionOne", Availability: gophercloud.AvailabilityPublic}
+	url, err := client.LocateEndpoint(eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute-1.example.com/", url)
+
+	// The catalog of the new token replaces the one of the old token.
+	th.AssertNoErr(t, client.ReauthFunc(context.Background()))
+	th.CheckEquals(t, "token-2", client.Token())
+	url, err = client.LocateEndpoint(eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute-2.example.com/", url)
+
+	// Service clients can be created while the client re-authenticates.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			_, err := openstack.NewComputeV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+			th.CheckNoErr(t, err)
+		}
+	}()
+	th.AssertNoErr(t, client.ReauthFunc(context.Background()))
+	<-done
+}
+
+func TestAuthenticateV3ProjectDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": {
+								"name": "me",
+								"password": "secret",
+								"domain": { "name": "users" }
+							}
+						}
+					},
+					"scope": {
+						"project": {
+							"name": "myproject",
+							"domain": { "id": "a7e1b2" }
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "users",
+		TenantName:       "myproject",
+		ProjectDomainID:  "a7e1b2",
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "0123456789", client.TokenID)
+}
+
+func TestAuthenticateV3SystemScope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": { "id": "me", "password": "secret" }
+						}
+					},
+					"scope": {
+						"system": { "all": true }
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "0123456789")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "me",
+		Password:         "secret",
+		TenantName:       "ignored",
+		Scope:            &gophercloud.AuthScope{System: true},
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+}
+
+func TestAuthenticateV3TrustScope(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	tokens := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": { "id": "trustee", "password": "secret" }
+						}
+					},
+					"scope": {
+						"OS-TRUST:trust": { "id": "de0945a" }
+					}
+				}
+			}
+		`)
+
+		tokens++
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("trust-token-%d", tokens))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "trustee",
+		Password:         "secret",
+		AllowReauth:      true,
+		Scope:            &gophercloud.AuthScope{TrustID: "de0945a"},
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "trust-token-1", client.Token())
+
+	// Re-authentication gets a new token scoped to the same trust.
+	err = client.Reauthenticate(context.Background(), client.Token())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "trust-token-2", client.Token())
+}
+
+func TestAuthenticateV3AuthReceipt(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		if r.Header.Get("Openstack-Auth-Receipt") == "" {
+			th.TestJSONRequest(t, r, `
+				{
+					"auth": {
+						"identity": {
+							"methods": ["password"],
+							"password": {
+								"user": { "id": "me", "password": "secret" }
+							}
+						}
+					}
+				}
+			`)
+
+			w.Header().Add("Openstack-Auth-Receipt", "receipt-123")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{ "receipt": { "methods": ["password"] }, "required_auth_methods": [["password", "totp"]] }`)
+			return
+		}
+
+		th.TestHeader(t, r, "Openstack-Auth-Receipt", "receipt-123")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["totp"],
+						"totp": {
+							"user": { "id": "me", "passcode": "123456" }
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "mfa-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "me",
+		Password:         "secret",
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	receiptErr, ok := err.(tokens3.ErrAdditionalAuthRequired)
+	if !ok {
+		t.Fatalf("Expected an ErrAdditionalAuthRequired, got %#v", err)
+	}
+	th.CheckDeepEquals(t, [][]string{{"password", "totp"}}, receiptErr.Receipt.RequiredAuthMethods)
+
+	options = gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "me",
+		Passcode:         "123456",
+		Receipt:          receiptErr.Receipt.ID,
+	}
+	err = openstack.AuthenticateV3(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "mfa-token", client.Token())
+}
+
+func TestAuthenticateV3Federated(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	unscoped := 0
+	th.Mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/corp/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", "Bearer oidc-access-token")
+
+		unscoped++
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("unscoped-token-%d", unscoped))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	scoped := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, fmt.Sprintf(`
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": { "id": "unscoped-token-%d" }
+					},
+					"scope": {
+						"project": { "id": "263fd9" }
+					}
+				}
+			}
+		`, unscoped))
+
+		scoped++
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("project-token-%d", scoped))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	options := federation.AuthOptions{
+		IdentityProvider: "corp",
+		Protocol:         "openid",
+		AccessToken:      "oidc-access-token",
+		AllowReauth:      true,
+		Scope:            &gophercloud.AuthScope{ProjectID: "263fd9"},
+	}
+	err = openstack.AuthenticateV3Federated(client, options, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "project-token-1", client.Token())
+
+	// Re-authentication goes through the identity provider again.
+	err = client.Reauthenticate(context.Background(), client.Token())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, unscoped)
+	th.AssertEquals(t, "project-token-2", client.Token())
+}
+
+func TestRescopeV3(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var passwordTokens, scopedTokens int
+	expired := map[string]bool{}
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		var body struct {
+			Auth struct {
+				Identity struct {
+					Methods []string `json:"methods"`
+					Token   struct {
+						ID string `json:"id"`
+					} `json:"token"`
+				} `json:"identity"`
+				Scope map[string]interface{} `json:"scope"`
+			} `json:"auth"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body.Auth.Identity.Methods[0] {
+		case "password":
+			passwordTokens++
+			w.Header().Add("X-Subject-Token", fmt.Sprintf("admin-%d", passwordTokens))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z", "catalog": [] } }`)
+		case "token":
+			if expired[body.Auth.Identity.Token.ID] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			th.CheckDeepEquals(t, map[string]interface{}{"project": map[string]interface{}{"id": "p2"}}, body.Auth.Scope)
+			scopedTokens++
+			w.Header().Add("X-Subject-Token", fmt.Sprintf("p2-%d", scopedTokens))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{
+				"token": {
+					"expires_at": "2013-02-02T18:30:59.000000Z",
+					"catalog": [
+						{
+							"type": "compute",
+							"endpoints": [
+								{ "interface": "public", "region": "RegionOne", "url": "https://compute-%d.example.com/p2" }
+							]
+						}
+					]
+				}
+			}`, scopedTokens)
+		}
+	})
+
+	admin, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+	err = openstack.AuthenticateV3(admin, gophercloud.AuthOptions{
+		IdentityEndpoint: th.Endpoint(),
+		UserID:           "admin",
+		Password:         "secret",
+		AllowReauth:      true,
+	}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "admin-1", admin.TokenID)
+	admin.TokenRenewalMargin = time.Minute
+
+	client, err := openstack.RescopeV3(admin, gophercloud.AuthScope{ProjectID: "p2"}, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "p2-1", client.Token())
+	th.CheckEquals(t, "admin-1", admin.Token())
+
+	// The scoped token expires with the token of the admin, so renewing it
+	// ahead of time would only fetch it again.
+	th.CheckEquals(t, time.Duration(0), client.TokenRenewalMargin)
+
+	eo := gophercloud.EndpointOpts{Type: "compute", Region: "RegionOne", Availability: gophercloud.AvailabilityPublic}
+	url, err := client.LocateEndpoint(eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute-1.example.com/p2/", url)
+
+	// Once the token of the admin has expired, it is renewed before the
+	// scoped token.
+	expired["admin-1"] = true
+	th.AssertNoErr(t, client.ReauthFunc(context.Background()))
+	th.CheckEquals(t, "admin-2", admin.Token())
+	th.CheckEquals(t, "p2-2", client.Token())
+	th.CheckEquals(t, 2, passwordTokens)
+
+	// The catalog of the new scoped token replaces the previous one.
+	url, err = client.LocateEndpoint(eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute-2.example.com/p2/", url)
 }
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// MicroversionRange is an inclusive range of microversions. An empty Min or
+// Max leaves the range unbounded on that side.
+type MicroversionRange struct {
+	Min string
+	Max string
+}
+
+// SupportedMicroversions lists, by service type, the range of microversions
+// the resource packages of this library can handle. Services without an entry
+// are not restricted.
+var SupportedMicroversions = map[string]MicroversionRange{
+	"compute": {Min: "2.1"},
+}
+
+// MicroversionOpts specifies how ChooseMicroversion negotiates a microversion.
+type MicroversionOpts struct {
+	// Min and Max are the range of microversions the caller is able to use.
+	// Either may be left empty.
+	Min string
+	Max string
+
+	// VersionURL is the URL of the service's version document. It defaults to
+	// the ServiceClient's Endpoint.
+	VersionURL string
+}
+
+// ErrNoMicroversion is returned by ChooseMicroversion when the range of
+// microversions supported by the service doesn't overlap the ranges supported
+// by this library and requested by the caller.
+type ErrNoMicroversion struct {
+	gophercloud.BaseError
+	ServiceType string
+	Service     MicroversionRange
+	Requested   MicroversionRange
+}
+
+func (e ErrNoMicroversion) Error() string {
+	return fmt.Sprintf(
+		"No microversion of the %s service is supported by both the service [%s, %s] and the client [%s, %s]",
+		e.ServiceType, e.Service.Min, e.Service.Max, e.Requested.Min, e.Requested.Max,
+	)
+}
+
+// ChooseMicroversion reads the version document of the service a
+// ServiceClient talks to, and picks the highest microversion supported by the
+// service, by this library (see SupportedMicroversions) and by the caller (see
+// MicroversionOpts). It sets the ServiceClient's Microversion to the result
+// and returns it.
+//
+// It returns an ErrNoMicroversion if these ranges don't overlap, and an error
+// if the service doesn't support microversions.
+func ChooseMicroversion(client *gophercloud.ServiceClient, opts MicroversionOpts) (string, error) {
+	type linkResp struct {
+		Href string `json:"href"`
+		Rel  string `json:"rel"`
+	}
+
+	type versionResp struct {
+		ID         string     `json:"id"`
+		Status     string     `json:"status"`
+		Version    string     `json:"version"`
+		MinVersion string     `json:"min_version"`
+		Links      []linkResp `json:"links"`
+	}
+
+	// A versioned endpoint describes itself in "version", while the root of a
+	// service lists all its versions in "versions".
+	type response struct {
+		Version  *versionResp  `json:"version"`
+		Versions []versionResp `json:"versions"`
+	}
+
+	url := opts.VersionURL
+	if url == "" {
+		url = client.Endpoint
+	}
+
+	// The version document doesn't depend on the microversion, and a pinned
+	// one the service doesn't support would make it reject the request.
+	discovery := *client
+	discovery.Microversion = ""
+
+	var resp response
+	_, err := discovery.Get(url, &resp, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 300},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var chosen *versionResp
+	if resp.Version != nil {
+		chosen = resp.Version
+	} else {
+		endpoint := gophercloud.NormalizeURL(client.Endpoint)
+		for i, v := range resp.Versions {
+			for _, link := range v.Links {
+				if link.Rel == "self" && strings.HasPrefix(endpoint, gophercloud.NormalizeURL(link.Href)) {
+					chosen = &resp.Versions[i]
+				}
+			}
+		}
+		// Otherwise, use the current version of the service.
+		if chosen == nil {
+			for i, v := range resp.Versions {
+				if strings.ToLower(v.Status) == "current" {
+					chosen = &resp.Versions[i]
+				}
+			}
+		}
+	}
+
+	if chosen == nil || chosen.Version == "" {
+		return "", fmt.Errorf("The %s service at %s does not support microversions", client.Type, url)
+	}
+
+	service := MicroversionRange{Min: chosen.MinVersion, Max: chosen.Version}
+	if service.Min == "" {
+		service.Min = service.Max
+	}
+
+	requested := MicroversionRange{Min: opts.Min, Max: opts.Max}
+	if supported, ok := SupportedMicroversions[client.Type]; ok {
+		if requested, err = intersect(requested, supported); err != nil {
+			return "", err
+		}
+	}
+
+	overlap, err := intersect(service, requested)
+	if err != nil {
+		return "", err
+	}
+	if c, err := gophercloud.CompareMicroversions(overlap.Min, overlap.Max); err != nil {
+		return "", err
+	} else if c > 0 {
+		return "", ErrNoMicroversion{
+			ServiceType: client.Type,
+			Service:     service,
+			Requested:   requested,
+		}
+	}
+
+	client.Microversion = overlap.Max
+	return overlap.Max, nil
+}
+
+// intersect returns the intersection of two microversion ranges. The result
+// may be empty, that is have a Min greater than its Max.
+func intersect(a, b MicroversionRange) (MicroversionRange, error) {
+	r := a
+
+	if b.Min != "" {
+		if r.Min == "" {
+			r.Min = b.Min
+		} else if c, err := gophercloud.CompareMicroversions(b.Min, r.Min); err != nil {
+			return r, err
+		} else if c > 0 {
+			r.Min = b.Min
+		}
+	}
+
+	if b.Max != "" {
+		if r.Max == "" {
+			r.Max = b.Max
+		} else if c, err := gophercloud.CompareMicroversions(b.Max, r.Max); err != nil {
+			return r, err
+		} else if c < 0 {
+			r.Max = b.Max
+		}
+	}
+
+	return r, nil
+}
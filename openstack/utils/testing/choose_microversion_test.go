@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func setupMicroversionHandler(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "OpenStack-API-Version", "")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"status": "SUPPORTED",
+						"id": "v2.0",
+						"version": "",
+						"min_version": "",
+						"links": [
+							{ "href": "%s/v2/", "rel": "self" }
+						]
+					},
+					{
+						"status": "CURRENT",
+						"id": "v2.1",
+						"version": "2.79",
+						"min_version": "2.1",
+						"links": [
+							{ "href": "%s/v2.1/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`, th.Server.URL, th.Server.URL)
+	})
+}
+
+func TestChooseMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupMicroversionHandler(t)
+
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+
+	v, err := utils.ChooseMicroversion(sc, utils.MicroversionOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.79", v)
+	th.AssertEquals(t, "2.79", sc.Microversion)
+
+	v, err = utils.ChooseMicroversion(sc, utils.MicroversionOpts{Min: "2.10", Max: "2.60"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.60", v)
+	th.AssertEquals(t, "2.60", sc.Microversion)
+}
+
+func TestChooseMicroversionNoOverlap(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupMicroversionHandler(t)
+
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+
+	_, err := utils.ChooseMicroversion(sc, utils.MicroversionOpts{Min: "2.80"})
+	if _, ok := err.(utils.ErrNoMicroversion); !ok {
+		t.Fatalf("Expected an ErrNoMicroversion, got %v", err)
+	}
+	th.AssertEquals(t, "", sc.Microversion)
+}
+
+func TestChooseMicroversionUnsupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "version": { "id": "v2.0", "status": "CURRENT", "links": [] } }`)
+	})
+
+	_, err := utils.ChooseMicroversion(client.ServiceClient(), utils.MicroversionOpts{})
+	if err == nil {
+		t.Fatal("Expected an error from a service without microversions")
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Package otelgophercloud instruments a gophercloud ProviderClient with
+OpenTelemetry tracing and metrics.
+
+Instrument registers hooks on the ProviderClient which create a span for every
+API call, named after the service type, HTTP method and resource path template
+(e.g. "compute GET /servers/{id}"), and record its status code and OpenStack
+request ID. Each page fetched by a pagination.Pager gets its own span, tagged
+with the page index, and re-authentications are traced in their own span.
+
+The duration of every call is recorded in the "gophercloud.request.duration"
+histogram, and failed calls are counted by the "gophercloud.request.errors"
+counter.
+
+Example of Instrumenting a ProviderClient
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	err = otelgophercloud.Instrument(provider)
+	if err != nil {
+		panic(err)
+	}
+
+	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: "RegionOne",
+	})
+
+	server, err := servers.Get(computeClient.WithContext(ctx), "{serverId}").Extract()
+
+Instrument should be called once the ProviderClient has been authenticated,
+so that its re-authentication function can be traced. A re-authentication
+triggered by a 401 response is traced within the span of the caller, next to
+the span of the request which got it.
+
+Path templates replace resource IDs and names, including the account,
+container and object names of Object Storage paths, so that the number of
+distinct span names and metric attributes stays bounded.
+*/
+package otelgophercloud
@@ -0,0 +1,327 @@
+package otelgophercloud
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies this package to tracer and meter providers.
+const instrumentationName = "github.com/gophercloud/gophercloud/otelgophercloud"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	ServiceTypeKey = attribute.Key("openstack.service.type")
+	RequestIDKey   = attribute.Key("openstack.request_id")
+	PageKey        = attribute.Key("openstack.pagination.page")
+	MethodKey      = attribute.Key("http.request.method")
+	StatusCodeKey  = attribute.Key("http.response.status_code")
+	URLTemplateKey = attribute.Key("url.template")
+	URLFullKey     = attribute.Key("url.full")
+)
+
+// ReauthSpanName is the name of the span covering a re-authentication.
+const ReauthSpanName = "gophercloud reauthenticate"
+
+// unknownService is the service type recorded for requests that weren't
+// issued through a ServiceClient, such as authentication requests.
+const unknownService = "unknown"
+
+// Option customizes the instrumentation set up by Instrument.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the TracerProvider spans are created with. It
+// defaults to the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider metrics are recorded with. It
+// defaults to the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// instrumentation holds the tracer and instruments shared by the hooks of an
+// instrumented ProviderClient.
+type instrumentation struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// startKey is the context key under which the request started by
+// beforeRequest is stored.
+type startKey struct{}
+
+// started records the request beforeRequest started a span for, and when. The
+// context of a request may derive from the context of another one, such as
+// when a 401 response triggers a re-authentication, so the request is kept to
+// tell whether the span in the context belongs to it.
+type started struct {
+	req  *http.Request
+	time time.Time
+}
+
+// Instrument registers hooks on client which trace every request it makes and
+// record its duration and outcome. The hooks are appended to the client's
+// existing BeforeRequest and AfterResponse hooks. If the client can
+// re-authenticate, its ReauthFunc is wrapped so that re-authentications are
+// traced too.
+func Instrument(client *gophercloud.ProviderClient, opts ...Option) error {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	meter := c.meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram("gophercloud.request.duration",
+		metric.WithDescription("Duration of the HTTP requests made to OpenStack APIs."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+
+	errors, err := meter.Int64Counter("gophercloud.request.errors",
+		metric.WithDescription("Number of HTTP requests made to OpenStack APIs which failed."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return err
+	}
+
+	i := &instrumentation{
+		tracer:   c.tracerProvider.Tracer(instrumentationName),
+		duration: duration,
+		errors:   errors,
+	}
+
+	client.BeforeRequest = append(client.BeforeRequest, i.beforeRequest)
+	client.AfterResponse = append(client.AfterResponse, i.afterResponse)
+
+	if reauth := client.ReauthFunc; reauth != nil {
+		client.ReauthFunc = func(ctx context.Context) error {
+			return i.traceReauth(ctx, reauth)
+		}
+	}
+
+	return nil
+}
+
+// beforeRequest starts the span of a request, and binds the request to the
+// span's context so that spans created by the HTTP transport are nested in it.
+func (i *instrumentation) beforeRequest(req *http.Request) error {
+	serviceType := gophercloud.ServiceTypeFromContext(req.Context())
+	if serviceType == "" {
+		serviceType = unknownService
+	}
+	template := serviceTemplate(serviceType, req.URL.Path)
+
+	attrs := []attribute.KeyValue{
+		ServiceTypeKey.String(serviceType),
+		MethodKey.String(req.Method),
+		URLTemplateKey.String(template),
+		URLFullKey.String(redactURL(req.URL.String())),
+	}
+	if page := pagination.PageFromContext(req.Context()); page > 0 {
+		attrs = append(attrs, PageKey.Int(page))
+	}
+
+	ctx, _ := i.tracer.Start(req.Context(), serviceType+" "+req.Method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	ctx = context.WithValue(ctx, startKey{}, started{req: req, time: time.Now()})
+
+	*req = *req.WithContext(ctx)
+	return nil
+}
+
+// afterResponse ends the span started by beforeRequest and records metrics.
+// It does nothing if beforeRequest didn't run, because a BeforeRequest hook
+// called before it failed.
+func (i *instrumentation) afterResponse(req *http.Request, resp *http.Response, err error) {
+	ctx := req.Context()
+	start, ok := ctx.Value(startKey{}).(started)
+	if !ok || start.req != req {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+
+	serviceType := gophercloud.ServiceTypeFromContext(ctx)
+	if serviceType == "" {
+		serviceType = unknownService
+	}
+	attrs := []attribute.KeyValue{
+		ServiceTypeKey.String(serviceType),
+		MethodKey.String(req.Method),
+		URLTemplateKey.String(serviceTemplate(serviceType, req.URL.Path)),
+	}
+
+	failed := err != nil
+	if resp != nil {
+		attrs = append(attrs, StatusCodeKey.Int(resp.StatusCode))
+		span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+		if id := gophercloud.RequestID(resp.Header); id != "" {
+			span.SetAttributes(RequestIDKey.String(id))
+		}
+		if resp.StatusCode >= 400 {
+			failed = true
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+
+	i.duration.Record(ctx, time.Since(start.time).Seconds(), metric.WithAttributes(attrs...))
+	if failed {
+		i.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// traceReauth calls reauth within a span of its own. The throwaway client the
+// reauthentication is performed with shares the ProviderClient's hooks, so the
+// authentication requests are traced and measured like any other.
+func (i *instrumentation) traceReauth(ctx context.Context, reauth func(context.Context) error) error {
+	ctx, span := i.tracer.Start(ctx, ReauthSpanName, trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	err := reauth(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+var (
+	// uuidPattern matches UUIDs, with or without dashes.
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+	// idPattern matches numeric IDs and long hexadecimal IDs.
+	idPattern = regexp.MustCompile(`^([0-9]+|[0-9a-fA-F]{16,})$`)
+
+	// versionPattern matches API versions, which are kept in templates.
+	versionPattern = regexp.MustCompile(`^v[0-9]+(\.[0-9]+)?$`)
+)
+
+// namedCollections are the collections whose members are addressed by a
+// free-form name or key rather than by an ID.
+var namedCollections = map[string]bool{
+	"databases":      true,
+	"extensions":     true,
+	"metadata":       true,
+	"os-extra_specs": true,
+	"os-keypairs":    true,
+	"resource_types": true,
+	"resources":      true,
+	"stacks":         true,
+	"tags":           true,
+	"users":          true,
+}
+
+// objectStoreService is the service type of the Object Storage API.
+const objectStoreService = "object-store"
+
+// accountPrefix is the default reseller prefix of Object Storage accounts.
+const accountPrefix = "AUTH_"
+
+// PathTemplate returns the resource path template of a URL path, in which
+// the segments that look like resource IDs are replaced by "{id}", and the
+// members of collections addressed by name, such as "os-keypairs" or
+// "metadata", by "{name}". For example,
+// "/v2.0/ports/4e8e5957-649f-477b-9e5b-f1f75b21c03c" becomes
+// "/v2.0/ports/{id}".
+//
+// The account, container and object names of Object Storage paths are
+// replaced too, from the first segment with the "AUTH_" prefix, so that
+// "/v1/AUTH_account/container/a/b" becomes
+// "/v1/{account}/{container}/{object}".
+func PathTemplate(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if part == "" || versionPattern.MatchString(part) {
+			continue
+		}
+		if strings.HasPrefix(part, accountPrefix) {
+			return strings.Join(append(parts[:i], objectStoreTemplate(parts[i:])...), "/")
+		}
+		if uuidPattern.MatchString(part) || idPattern.MatchString(part) {
+			parts[i] = "{id}"
+		} else if i > 0 && namedCollections[parts[i-1]] {
+			parts[i] = "{name}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// serviceTemplate returns the template of a path of the given service. All
+// the segments following the API version of Object Storage paths are names,
+// whatever the reseller prefix of the account is.
+func serviceTemplate(serviceType, path string) string {
+	if serviceType != objectStoreService {
+		return PathTemplate(path)
+	}
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if versionPattern.MatchString(part) {
+			return strings.Join(append(parts[:i+1], objectStoreTemplate(parts[i+1:])...), "/")
+		}
+	}
+	return PathTemplate(path)
+}
+
+// objectStoreTemplate replaces the account, container and object segments
+// of an Object Storage path. Object names may contain slashes, so all the
+// segments after the container are replaced by a single "{object}".
+func objectStoreTemplate(parts []string) []string {
+	names := []string{"{account}", "{container}", "{object}"}
+	last := len(names) - 1
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		if i >= last {
+			return append(parts[:last], names[last])
+		}
+		parts[i] = names[i]
+	}
+	return parts
+}
+
+// redactURL removes the signature of Swift temp URLs from a URL.
+func redactURL(u string) string {
+	i := strings.Index(u, "temp_url_sig=")
+	if i < 0 {
+		return u
+	}
+	j := strings.IndexByte(u[i:], '&')
+	if j < 0 {
+		return u[:i] + "temp_url_sig=***"
+	}
+	return u[:i] + "temp_url_sig=***" + u[i+j:]
+}
@@ -0,0 +1,256 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/otelgophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// recordedSpan is a span whose name, attributes and status are recorded.
+type recordedSpan struct {
+	noop.Span
+	name   string
+	parent trace.Span
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, a := range kv {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) {
+	s.status = code
+}
+
+func (s *recordedSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
+
+// recordingTracerProvider records the spans started by its tracers.
+type recordingTracerProvider struct {
+	noop.TracerProvider
+	mut   sync.Mutex
+	spans []*recordedSpan
+}
+
+func (tp *recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return recordingTracer{tp: tp}
+}
+
+type recordingTracer struct {
+	noop.Tracer
+	tp *recordingTracerProvider
+}
+
+func (t recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &recordedSpan{name: name, parent: trace.SpanFromContext(ctx), attrs: make(map[attribute.Key]attribute.Value)}
+	cfg := trace.NewSpanStartConfig(opts...)
+	span.SetAttributes(cfg.Attributes()...)
+
+	t.tp.mut.Lock()
+	t.tp.spans = append(t.tp.spans, span)
+	t.tp.mut.Unlock()
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func TestInstrument(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("X-Openstack-Request-Id", "req-1234")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	tp := new(recordingTracerProvider)
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+	err := otelgophercloud.Instrument(sc.ProviderClient, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	_, err = sc.Get(sc.ServiceURL("servers", "4e8e5957-649f-477b-9e5b-f1f75b21c03c"), nil, nil)
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %#v", err)
+	}
+
+	th.AssertEquals(t, 1, len(tp.spans))
+	span := tp.spans[0]
+	th.AssertEquals(t, "compute GET /servers/{id}", span.name)
+	th.AssertEquals(t, true, span.ended)
+	th.AssertEquals(t, codes.Error, span.status)
+	th.AssertEquals(t, "compute", span.attrs[otelgophercloud.ServiceTypeKey].AsString())
+	th.AssertEquals(t, "/servers/{id}", span.attrs[otelgophercloud.URLTemplateKey].AsString())
+	th.AssertEquals(t, int64(http.StatusNotFound), span.attrs[otelgophercloud.StatusCodeKey].AsInt64())
+	th.AssertEquals(t, "req-1234", span.attrs[otelgophercloud.RequestIDKey].AsString())
+}
+
+func TestInstrumentPagination(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"items": [1], "links": {"next": "%s"}}`, th.Endpoint()+"items?page=2")
+		default:
+			fmt.Fprintf(w, `{"items": [2]}`)
+		}
+	})
+
+	tp := new(recordingTracerProvider)
+	sc := client.ServiceClient()
+	err := otelgophercloud.Instrument(sc.ProviderClient, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	pager := pagination.NewPager(sc, sc.ServiceURL("items"), func(r pagination.PageResult) pagination.Page {
+		return itemsPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+	err = pager.EachPage(func(pagination.Page) (bool, error) {
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(tp.spans))
+	for i, span := range tp.spans {
+		th.AssertEquals(t, "unknown GET /items", span.name)
+		th.AssertEquals(t, int64(i+1), span.attrs[otelgophercloud.PageKey].AsInt64())
+	}
+}
+
+func TestInstrumentReauth(t *testing.T) {
+	tp := new(recordingTracerProvider)
+	p := &gophercloud.ProviderClient{
+		ReauthFunc: func(context.Context) error {
+			return nil
+		},
+	}
+	err := otelgophercloud.Instrument(p, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, p.ReauthFunc(context.Background()))
+	th.AssertEquals(t, 1, len(tp.spans))
+	th.AssertEquals(t, otelgophercloud.ReauthSpanName, tp.spans[0].name)
+	th.AssertEquals(t, true, tp.spans[0].ended)
+}
+
+func TestInstrumentReauthAfter401(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	tp := new(recordingTracerProvider)
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+	sc.ProviderClient.ReauthFunc = func(context.Context) error {
+		sc.ProviderClient.SetToken("new-token")
+		return nil
+	}
+	err := otelgophercloud.Instrument(sc.ProviderClient, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	ctx, caller := tp.Tracer("").Start(context.Background(), "caller")
+	_, err = sc.WithContext(ctx).Get(sc.ServiceURL("servers"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	// The re-authentication is traced within the span of the caller, since
+	// the span of the request which got the 401 response has ended.
+	th.AssertEquals(t, 4, len(tp.spans))
+	th.AssertEquals(t, "compute GET /servers", tp.spans[1].name)
+	th.AssertEquals(t, caller, tp.spans[1].parent)
+	th.AssertEquals(t, true, tp.spans[1].ended)
+	th.AssertEquals(t, otelgophercloud.ReauthSpanName, tp.spans[2].name)
+	th.AssertEquals(t, caller, tp.spans[2].parent)
+	th.AssertEquals(t, "compute GET /servers", tp.spans[3].name)
+	th.AssertEquals(t, caller, tp.spans[3].parent)
+}
+
+func TestInstrumentObjectStore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tp := new(recordingTracerProvider)
+	sc := client.ServiceClient()
+	sc.Type = "object-store"
+	err := otelgophercloud.Instrument(sc.ProviderClient, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	for _, path := range []string{"v1/account/photos/2024/cat.jpg", "v1/other/music/song.mp3"} {
+		_, err = sc.Delete(sc.ServiceURL(path), nil)
+		th.AssertNoErr(t, err)
+	}
+
+	th.AssertEquals(t, 2, len(tp.spans))
+	for _, span := range tp.spans {
+		th.AssertEquals(t, "object-store DELETE /v1/{account}/{container}/{object}", span.name)
+	}
+}
+
+func TestInstrumentFailingHook(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	tp := new(recordingTracerProvider)
+	sc := client.ServiceClient()
+	err := otelgophercloud.Instrument(sc.ProviderClient, otelgophercloud.WithTracerProvider(tp))
+	th.AssertNoErr(t, err)
+
+	// The hooks of the ServiceClient are called after the ones of the
+	// instrumentation, so the span of a request they abort is still ended.
+	sc.BeforeRequest = []gophercloud.BeforeRequestHook{
+		func(*http.Request) error {
+			return fmt.Errorf("aborted")
+		},
+	}
+	_, err = sc.Get(sc.ServiceURL("servers"), nil, nil)
+	th.AssertEquals(t, "aborted", err.Error())
+
+	th.AssertEquals(t, 1, len(tp.spans))
+	th.AssertEquals(t, true, tp.spans[0].ended)
+	th.AssertEquals(t, codes.Error, tp.spans[0].status)
+}
+
+func TestPathTemplate(t *testing.T) {
+	th.CheckEquals(t, "/v2.0/ports/{id}", otelgophercloud.PathTemplate("/v2.0/ports/4e8e5957-649f-477b-9e5b-f1f75b21c03c"))
+	th.CheckEquals(t, "/v2/{id}/servers/{id}/action", otelgophercloud.PathTemplate("/v2/fcad67a6189847c4aecfa3c81a05783b/servers/12345/action"))
+	th.CheckEquals(t, "/v2.1/os-keypairs/{name}", otelgophercloud.PathTemplate("/v2.1/os-keypairs/my-key"))
+	th.CheckEquals(t, "/v2/servers/{id}/metadata/{name}", otelgophercloud.PathTemplate("/v2/servers/12345/metadata/color"))
+	th.CheckEquals(t, "/v1/stacks/{name}/{id}/resources/{name}", otelgophercloud.PathTemplate("/v1/stacks/web/4e8e5957-649f-477b-9e5b-f1f75b21c03c/resources/server"))
+	th.CheckEquals(t, "/v1/{account}", otelgophercloud.PathTemplate("/v1/AUTH_account"))
+	th.CheckEquals(t, "/v1/{account}/{container}/", otelgophercloud.PathTemplate("/v1/AUTH_account/container/"))
+	th.CheckEquals(t, "/v1/{account}/{container}/{object}", otelgophercloud.PathTemplate("/v1/AUTH_account/container/a/b/c"))
+}
+
+type itemsPage struct {
+	pagination.LinkedPageBase
+}
+
+func (p itemsPage) IsEmpty() (bool, error) {
+	return false, nil
+}
@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -68,8 +69,31 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 	}
 }
 
-func (p Pager) fetchNextPage(url string) (Page, error) {
-	resp, err := Request(p.client, p.Headers, url)
+// WithContext returns a new Pager whose page requests are bound to ctx.
+// Cancelling ctx stops EachPage and AllPages before the next page is fetched.
+func (p Pager) WithContext(ctx context.Context) Pager {
+	if p.Err != nil {
+		return p
+	}
+	p.client = p.client.WithContext(ctx)
+	return p
+}
+
+// pageKey is the context key under which the index of the page a request
+// fetches is stored.
+type pageKey struct{}
+
+// PageFromContext returns the 1-based index of the page fetched by the request
+// a context belongs to. Hooks can call it with the context of the http.Request
+// they receive. It returns 0 for requests that weren't issued by a Pager.
+func PageFromContext(ctx context.Context) int {
+	n, _ := ctx.Value(pageKey{}).(int)
+	return n
+}
+
+func (p Pager) fetchNextPage(url string, page int) (Page, error) {
+	client := p.client.WithContext(context.WithValue(p.client.Context(), pageKey{}, page))
+	resp, err := Request(client, p.Headers, url)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +113,8 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 		return p.Err
 	}
 	currentURL := p.initialURL
-	for {
-		currentPage, err := p.fetchNextPage(currentURL)
+	for page := 1; ; page++ {
+		currentPage, err := p.fetchNextPage(currentURL, page)
 		if err != nil {
 			return err
 		}
@@ -124,13 +148,16 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 // AllPages returns all the pages from a `List` operation in a single page,
 // allowing the user to retrieve all the pages at once.
 func (p Pager) AllPages() (Page, error) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
 	// pagesSlice holds all the pages until they get converted into as Page Body.
 	var pagesSlice []interface{}
 	// body will contain the final concatenated Page body.
 	var body reflect.Value
 
 	// Grab a test page to ascertain the page body type.
-	testPage, err := p.fetchNextPage(p.initialURL)
+	testPage, err := p.fetchNextPage(p.initialURL, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,11 @@
 package testing
 
 import (
+	"context"
+	"testing"
+
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
 	"github.com/gophercloud/gophercloud/testhelper"
 )
 
@@ -11,3 +15,15 @@ func createClient() *gophercloud.ServiceClient {
 		Endpoint:       testhelper.Endpoint(),
 	}
 }
+
+func TestPagerErr(t *testing.T) {
+	pager := pagination.Pager{Err: gophercloud.ErrMissingInput{Argument: "id"}}.WithContext(context.Background())
+
+	_, err := pager.AllPages()
+	testhelper.AssertDeepEquals(t, gophercloud.ErrMissingInput{Argument: "id"}, err)
+
+	err = pager.EachPage(func(pagination.Page) (bool, error) {
+		return true, nil
+	})
+	testhelper.AssertDeepEquals(t, gophercloud.ErrMissingInput{Argument: "id"}, err)
+}
@@ -97,7 +97,9 @@ func BuildRequestBody(opts interface{}, parent string) (map[string]interface{},
 				}
 			}
 
-			if v.Kind() == reflect.Struct || (v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct) {
+			// Nested structs are validated too, except for timestamps, which
+			// have no exported fields.
+			if (v.Kind() == reflect.Struct || (v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct)) && !isTime(v) {
 				if zero {
 					//fmt.Printf("value before change: %+v\n", optsValue.Field(i))
 					if jsonTag := f.Tag.Get("json"); jsonTag != "" {
@@ -225,6 +227,15 @@ func isUnderlyingStructZero(v reflect.Value) bool {
 
 var t time.Time
 
+// isTime tells whether v is a time.Time or a pointer to one.
+func isTime(v reflect.Value) bool {
+	typ := v.Type()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ == reflect.TypeOf(t)
+}
+
 func isZero(v reflect.Value) bool {
 	//fmt.Printf("\n\nchecking isZero for value: %+v\n", v)
 	switch v.Kind() {
@@ -305,8 +316,18 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 			if qTag != "" {
 				tags := strings.Split(qTag, ",")
 
+				// A pointer, such as a *bool, is set whenever it isn't nil,
+				// even if it points to a zero value.
+				set := !isZero(v)
+				if v.Kind() == reflect.Ptr {
+					set = !v.IsNil()
+					if set {
+						v = v.Elem()
+					}
+				}
+
 				// if the field is set, add it to the slice of query pieces
-				if !isZero(v) {
+				if set {
 					switch v.Kind() {
 					case reflect.String:
 						params.Add(tags[0], v.String())
@@ -392,8 +413,18 @@ func BuildHeaders(opts interface{}) (map[string]string, error) {
 			if hTag != "" {
 				tags := strings.Split(hTag, ",")
 
+				// A pointer, such as a *bool, is set whenever it isn't nil,
+				// even if it points to a zero value.
+				set := !isZero(v)
+				if v.Kind() == reflect.Ptr {
+					set = !v.IsNil()
+					if set {
+						v = v.Elem()
+					}
+				}
+
 				// if the field is set, add it to the slice of query pieces
-				if !isZero(v) {
+				if set {
 					switch v.Kind() {
 					case reflect.String:
 						optsMap[tags[0]] = v.String()
@@ -2,11 +2,14 @@ package gophercloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -51,10 +54,21 @@ type ProviderClient struct {
 	IdentityEndpoint string
 
 	// TokenID is the ID of the most recently issued valid token.
+	// NOTE: Aside from within a custom ReauthFunc, this field shouldn't be set by an application.
+	// To safely read or write this value, call `Token` or `SetToken`, respectively.
 	TokenID string
 
+	// tokenExpiresAt is the time at which TokenID expires, if known. To safely
+	// read or write this value, call `TokenExpiresAt` or `SetTokenExpiresAt`.
+	tokenExpiresAt time.Time
+
+	// renewalFailedAt is the expiry of the token a renewal ahead of time last
+	// failed to extend. It is protected by mut.
+	renewalFailedAt time.Time
+
 	// EndpointLocator describes how this provider discovers the endpoints for
-	// its constituent services.
+	// its constituent services. It is replaced on re-authentication. To safely
+	// read or write this value, call `LocateEndpoint` or `SetEndpointLocator`.
 	EndpointLocator EndpointLocator
 
 	// HTTPClient allows users to interject arbitrary http, https, or other transit behaviors.
@@ -66,20 +80,289 @@ type ProviderClient struct {
 	// ReauthFunc is the function used to re-authenticate the user if the request
 	// fails with a 401 HTTP response code. This a needed because there may be multiple
 	// authentication functions for different Identity service versions.
-	ReauthFunc func() error
-
+	//
+	// ReauthFunc is called with the context the request that triggered the
+	// re-authentication was made with, so it is cancelled along with that
+	// request.
+	//
+	// At most one ReauthFunc call is in flight at any time: requests that fail
+	// while a re-authentication is ongoing wait for it, then retry with the new
+	// token.
+	ReauthFunc func(ctx context.Context) error
+
+	// TokenRenewalMargin enables proactive token renewal. When it is positive and
+	// the token's expiry is known, a request sent less than TokenRenewalMargin
+	// before the token expires first calls ReauthFunc to obtain a new token. If
+	// that fails, or the new token doesn't expire later than the old one, the
+	// request is sent with the current token, and the token isn't renewed ahead
+	// of time again until its expiry changes, such as after a 401 response.
+	TokenRenewalMargin time.Duration
+
+	// OnReauth, if set, is called after every call to ReauthFunc, whether it
+	// was triggered by a 401 response or by TokenRenewalMargin, with the expiry
+	// of the token and the error ReauthFunc returned, if any.
+	OnReauth func(expiresAt time.Time, err error)
+
+	// RetryPolicy, if set, decides whether requests that failed with a
+	// transient error are sent again. See BackoffRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// BeforeRequest is an ordered chain of hooks called with every HTTP request
+	// made by this ProviderClient, after its headers have been populated and
+	// before it is sent. Hooks may modify the request. A ServiceClient may
+	// extend this chain with hooks of its own, which are called after these.
+	BeforeRequest []BeforeRequestHook
+
+	// AfterResponse is an ordered chain of hooks called after every HTTP
+	// request made by this ProviderClient, with the response and error returned
+	// by the HTTPClient. A ServiceClient may extend this chain with hooks of its
+	// own, which are called after these.
+	AfterResponse []AfterResponseHook
+
+	// Logger, if set, receives a RequestLog for every request made by this
+	// ProviderClient.
+	Logger Logger
+
+	// Debug adds the JSON request and response bodies to every RequestLog. If no
+	// Logger is set, it also enables logging through the DefaultLogger.
 	Debug bool
+
+	// DryRun, if set, records every request other than GET and HEAD requests
+	// instead of sending it. See DryRun for details.
+	DryRun *DryRun
+
+	// locatorWrappers are the functions passed to WrapEndpointLocator, in
+	// order. They are protected by mut.
+	locatorWrappers []func(EndpointLocator) EndpointLocator
+
+	// mut is a mutex for the client. It protects read and write access to client
+	// attributes such as getting and setting the TokenID.
+	mut sync.RWMutex
+
+	// reauthmut ensures that only one re-authentication happens at a time.
+	reauthmut reauthlock
+}
+
+// reauthlock coordinates the goroutines that need a re-authentication.
+type reauthlock struct {
+	sync.Mutex
+	ongoing *reauthFuture
+}
+
+// reauthFuture is the outcome of an ongoing re-authentication, shared by every
+// goroutine that waits for it.
+type reauthFuture struct {
+	done chan struct{}
+	err  error
+
+	// cancelled is set if the re-authentication failed because the context
+	// of the request that started it was done.
+	cancelled bool
+}
+
+// Token safely reads the value of the token ID from the ProviderClient.
+func (client *ProviderClient) Token() string {
+	client.mut.RLock()
+	defer client.mut.RUnlock()
+	return client.TokenID
+}
+
+// SetToken safely sets the value of the token ID on the ProviderClient.
+func (client *ProviderClient) SetToken(t string) {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	client.TokenID = t
+}
+
+// TokenExpiresAt safely reads the expiry of the token from the ProviderClient.
+// It returns the zero time if the expiry is unknown.
+func (client *ProviderClient) TokenExpiresAt() time.Time {
+	client.mut.RLock()
+	defer client.mut.RUnlock()
+	return client.tokenExpiresAt
+}
+
+// SetTokenExpiresAt safely sets the expiry of the token on the ProviderClient.
+func (client *ProviderClient) SetTokenExpiresAt(t time.Time) {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	client.tokenExpiresAt = t
+}
+
+// LocateEndpoint safely calls the EndpointLocator of the ProviderClient to
+// find the endpoint of a service.
+func (client *ProviderClient) LocateEndpoint(opts EndpointOpts) (string, error) {
+	client.mut.RLock()
+	locator := client.EndpointLocator
+	client.mut.RUnlock()
+
+	if locator == nil {
+		return "", ErrMissingInput{Argument: "EndpointLocator"}
+	}
+	return locator(opts)
+}
+
+// SetEndpointLocator safely sets the EndpointLocator of the ProviderClient,
+// wrapped by the functions passed to WrapEndpointLocator.
+func (client *ProviderClient) SetEndpointLocator(locator EndpointLocator) {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	client.setEndpointLocator(locator)
+}
+
+// WrapEndpointLocator safely replaces the EndpointLocator of the
+// ProviderClient with wrap(EndpointLocator). Every EndpointLocator set later by
+// SetEndpointLocator or CopyTokenFrom, such as on re-authentication, is
+// wrapped the same way before it is used.
+func (client *ProviderClient) WrapEndpointLocator(wrap func(EndpointLocator) EndpointLocator) {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	client.locatorWrappers = append(client.locatorWrappers, wrap)
+	if client.EndpointLocator != nil {
+		client.EndpointLocator = wrap(client.EndpointLocator)
+	}
+}
+
+// setEndpointLocator sets the EndpointLocator, wrapped by locatorWrappers.
+// mut must be held.
+func (client *ProviderClient) setEndpointLocator(locator EndpointLocator) {
+	for _, wrap := range client.locatorWrappers {
+		locator = wrap(locator)
+	}
+	client.EndpointLocator = locator
+}
+
+// CopyTokenFrom safely copies the token, its expiry and the EndpointLocator,
+// which holds the service catalog of the token, from another ProviderClient
+// into this one. It is used by ReauthFunc implementations which authenticate
+// with a throwaway copy of the ProviderClient.
+func (client *ProviderClient) CopyTokenFrom(other *ProviderClient) {
+	other.mut.RLock()
+	token, expiresAt, locator := other.TokenID, other.tokenExpiresAt, other.EndpointLocator
+	other.mut.RUnlock()
+
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	client.TokenID = token
+	client.tokenExpiresAt = expiresAt
+	if locator != nil {
+		client.setEndpointLocator(locator)
+	}
+}
+
+// tokenNeedsRenewal reports whether the token expires within
+// TokenRenewalMargin and can be renewed. A token that a previous renewal
+// failed to extend isn't renewed again, so that every request doesn't wait
+// for another attempt.
+func (client *ProviderClient) tokenNeedsRenewal() bool {
+	if client.ReauthFunc == nil || client.TokenRenewalMargin <= 0 {
+		return false
+	}
+	client.mut.RLock()
+	expiresAt, failedAt := client.tokenExpiresAt, client.renewalFailedAt
+	client.mut.RUnlock()
+	return !expiresAt.IsZero() && !expiresAt.Equal(failedAt) && time.Now().Add(client.TokenRenewalMargin).After(expiresAt)
+}
+
+// renewToken renews the token ahead of its expiry. If the renewal fails, or
+// doesn't extend the expiry, the token isn't renewed ahead of time again.
+func (client *ProviderClient) renewToken(ctx context.Context) {
+	expiresAt := client.TokenExpiresAt()
+	err := client.reauthenticate(ctx, client.Token())
+	if err != nil || !client.TokenExpiresAt().After(expiresAt) {
+		client.mut.Lock()
+		client.renewalFailedAt = client.tokenExpiresAt
+		client.mut.Unlock()
+	}
+}
+
+// Reauthenticate calls ReauthFunc to replace previousToken, the token that was
+// found to be invalid. Like a re-authentication triggered by a request, it
+// returns immediately if the token has already been replaced, and waits for
+// any ongoing re-authentication instead of starting another one. Cancelling
+// ctx aborts the wait, or the re-authentication itself.
+func (client *ProviderClient) Reauthenticate(ctx context.Context, previousToken string) error {
+	if client.ReauthFunc == nil {
+		return ErrUnableToReauthenticate{ErrOriginal: ErrMissingInput{Argument: "ReauthFunc"}}
+	}
+	return client.reauthenticate(ctx, previousToken)
+}
+
+// reauthenticate calls ReauthFunc to replace the token that was rejected by a
+// request. If the token has already been replaced since previousToken was
+// read, it returns immediately. If another goroutine is already
+// re-authenticating, it waits for it and returns its outcome.
+func (client *ProviderClient) reauthenticate(ctx context.Context, previousToken string) error {
+	client.reauthmut.Lock()
+	for client.reauthmut.ongoing != nil {
+		ongoing := client.reauthmut.ongoing
+		client.reauthmut.Unlock()
+		select {
+		case <-ongoing.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		// The re-authentication is bound to the context of the request that
+		// started it. If that request was cancelled, try again with this one.
+		if !ongoing.cancelled {
+			return ongoing.err
+		}
+		client.reauthmut.Lock()
+	}
+
+	if client.Token() != previousToken {
+		client.reauthmut.Unlock()
+		return nil
+	}
+
+	future := &reauthFuture{done: make(chan struct{})}
+	client.reauthmut.ongoing = future
+	client.reauthmut.Unlock()
+
+	future.err = client.callReauthFunc(ctx)
+	future.cancelled = future.err != nil && ctx.Err() != nil
+
+	client.reauthmut.Lock()
+	client.reauthmut.ongoing = nil
+	client.reauthmut.Unlock()
+	close(future.done)
+
+	return future.err
+}
+
+// callReauthFunc calls ReauthFunc, then OnReauth.
+func (client *ProviderClient) callReauthFunc(ctx context.Context) error {
+	err := client.ReauthFunc(ctx)
+	if client.OnReauth != nil {
+		client.OnReauth(client.TokenExpiresAt(), err)
+	}
+	return err
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() map[string]string {
-	if client.TokenID == "" {
+	t := client.Token()
+	if t == "" {
 		return map[string]string{}
 	}
-	return map[string]string{"X-Auth-Token": client.TokenID}
+	return map[string]string{"X-Auth-Token": t}
 }
 
+// BeforeRequestHook is called with an HTTP request before it is sent. It may
+// inspect and modify the request. If it returns an error, the request is not
+// sent, the AfterResponse hooks are called with the error, so that they can
+// release what the hooks which ran before set up, and the error is returned to
+// the caller.
+type BeforeRequestHook func(req *http.Request) error
+
+// AfterResponseHook is called once an HTTP request has been sent, with the
+// response, if any, and the error returned by the HTTP client. It is also
+// called, with a nil response, when a BeforeRequest hook failed. A hook which
+// reads the response body must replace it, so that it can still be consumed
+// by the caller.
+type AfterResponseHook func(req *http.Request, resp *http.Response, err error)
+
 // RequestOpts customizes the behavior of the provider.Request() method.
 type RequestOpts struct {
 	// JSONBody, if provided, will be encoded as JSON and used as the body of the HTTP request. The
@@ -102,14 +385,72 @@ type RequestOpts struct {
 	// ErrorContext specifies the resource error type to return if an error is encountered.
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
+	// DrainResponseBody specifies whether to read and close the body of a successful response when
+	// no JSONResponse is given, for callers which only need its status and headers, so that the
+	// underlying connection can be reused. Otherwise, the body is left open for the caller to read,
+	// and the caller must close it.
+	DrainResponseBody bool
+
+	// beforeRequest and afterResponse are called after the ProviderClient's
+	// hooks. They are set by a ServiceClient which has hooks of its own.
+	beforeRequest []BeforeRequestHook
+	afterResponse []AfterResponseHook
 }
 
 var applicationJSON = "application/json"
 
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
-// header will automatically be provided.
+// header will automatically be provided. Use RequestWithContext to cancel the request, or set a
+// deadline on it.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	return client.RequestWithContext(context.Background(), method, url, options)
+}
+
+// RequestWithContext performs an HTTP request in the same way as Request, but binds it to the
+// provided context. Cancelling ctx, or exceeding its deadline, aborts the request.
+func (client *ProviderClient) RequestWithContext(ctx context.Context, method, url string, options *RequestOpts) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := client.doRequest(ctx, method, url, options, &requestState{})
+		if err == nil || client.RetryPolicy == nil || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay, retry := client.RetryPolicy.Retry(method, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+
+		// A raw body has been consumed by the failed attempt. It can only be
+		// sent again if it can be rewound.
+		if options.RawBody != nil {
+			seeker, ok := options.RawBody.(io.Seeker)
+			if !ok {
+				return resp, err
+			}
+			if _, serr := seeker.Seek(0, io.SeekStart); serr != nil {
+				return resp, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// requestState tracks what has been attempted while serving a single call to
+// RequestWithContext.
+type requestState struct {
+	// hasReauthenticated is set once the request has triggered a
+	// re-authentication, so that a second 401 isn't retried endlessly.
+	hasReauthenticated bool
+}
+
+func (client *ProviderClient) doRequest(ctx context.Context, method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
 	var body io.Reader
+	var rendered []byte
 	var contentType *string
 
 	// Derive the content body by either encoding an arbitrary object as JSON, or by taking a provided
@@ -119,7 +460,8 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			panic("Please provide only one of JSONBody or RawBody to gophercloud.Request().")
 		}
 
-		rendered, err := json.Marshal(options.JSONBody)
+		var err error
+		rendered, err = json.Marshal(options.JSONBody)
 		if err != nil {
 			return nil, err
 		}
@@ -137,6 +479,7 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
@@ -145,6 +488,16 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	}
 	req.Header.Set("Accept", applicationJSON)
 
+	// Renew the token ahead of its expiry, if requested. A failure is reported
+	// to OnReauth but otherwise ignored: the current token may still be
+	// accepted, and if it isn't, the 401 response triggers another attempt.
+	if client.tokenNeedsRenewal() {
+		client.renewToken(ctx)
+	}
+
+	// Remember the token the request is sent with, to tell whether a 401
+	// response calls for a new token or one has been issued since.
+	previousToken := client.Token()
 	for k, v := range client.AuthenticatedHeaders() {
 		req.Header.Add(k, v)
 	}
@@ -162,14 +515,45 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		}
 	}
 
-	// Set connection parameter to close the connection immediately when we've got the response
-	req.Close = true
+	beforeRequest, afterResponse := client.BeforeRequest, client.AfterResponse
+	if len(options.beforeRequest) > 0 {
+		beforeRequest = append(append([]BeforeRequestHook{}, beforeRequest...), options.beforeRequest...)
+	}
+	if len(options.afterResponse) > 0 {
+		afterResponse = append(append([]AfterResponseHook{}, afterResponse...), options.afterResponse...)
+	}
+
+	for _, hook := range beforeRequest {
+		if err := hook(req); err != nil {
+			for _, hook := range afterResponse {
+				hook(req, nil, err)
+			}
+			return nil, err
+		}
+	}
+
+	// Issue the request, unless it is captured by a dry run.
+	var resp *http.Response
+	captured := client.DryRun != nil && method != "GET" && method != "HEAD"
+	start := time.Now()
+	if captured {
+		resp = client.DryRun.capture(req, rendered, options)
+	} else {
+		resp, err = client.HTTPClient.Do(req)
+	}
+	if logger := client.logger(); logger != nil {
+		client.logRequest(logger, req, rendered, resp, err, time.Since(start))
+	}
 
-	// Issue the request.
-	resp, err := client.HTTPClient.Do(req)
+	for _, hook := range afterResponse {
+		hook(req, resp, err)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if captured {
+		return resp, ErrDryRun
+	}
 
 	// Allow default OkCodes if none explicitly set
 	if options.OkCodes == nil {
@@ -187,17 +571,19 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	if !ok {
 		body, _ := ioutil.ReadAll(resp.Body)
-		resp.Body.Close()
+		closeResponseBody(resp)
 		//pc := make([]uintptr, 1)
 		//runtime.Callers(2, pc)
 		//f := runtime.FuncForPC(pc[0])
 		respErr := ErrUnexpectedResponseCode{
-			URL:      url,
-			Method:   method,
-			Expected: options.OkCodes,
-			Actual:   resp.StatusCode,
-			Body:     body,
+			URL:       url,
+			Method:    method,
+			Expected:  options.OkCodes,
+			Actual:    resp.StatusCode,
+			Body:      body,
+			RequestID: RequestID(resp.Header),
 		}
+		respErr.Fault = parseFault(resp.StatusCode, respErr.RequestID, body)
 		//respErr.Function = "gophercloud.ProviderClient.Request"
 
 		errType := options.ErrorContext
@@ -208,8 +594,15 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 				err = error400er.Error400(respErr)
 			}
 		case http.StatusUnauthorized:
-			if client.ReauthFunc != nil {
-				err = client.ReauthFunc()
+			if client.ReauthFunc != nil && !state.hasReauthenticated {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				state.hasReauthenticated = true
+				// Re-authenticate with the caller's context rather than the
+				// one the hooks set up for the failed request, which may refer
+				// to a span that has already ended.
+				err = client.reauthenticate(ctx, previousToken)
 				if err != nil {
 					e := &ErrUnableToReauthenticate{}
 					e.ErrOriginal = respErr
@@ -220,7 +613,7 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 						seeker.Seek(0, 0)
 					}
 				}
-				resp, err = client.Request(method, url, options)
+				resp, err = client.doRequest(ctx, method, url, options, state)
 				if err != nil {
 					switch err.(type) {
 					case *ErrUnexpectedResponseCode:
@@ -280,15 +673,33 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	// Parse the response body as JSON, if requested to do so.
 	if options.JSONResponse != nil {
-		defer resp.Body.Close()
+		defer closeResponseBody(resp)
 		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
 			return nil, err
 		}
+		return resp, nil
+	}
+
+	// Close the unused body, so that the connection can be reused.
+	if options.DrainResponseBody {
+		closeResponseBody(resp)
 	}
 
 	return resp, nil
 }
 
+// maxDrainBytes bounds how much of a response body closeResponseBody reads.
+// Closing a longer body drops its connection, which is cheaper than reading
+// it to the end.
+const maxDrainBytes = 64 << 10
+
+// closeResponseBody reads the remainder of a response body, then closes it.
+// A body must be read to the end for its connection to be reused.
+func closeResponseBody(resp *http.Response) {
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+	resp.Body.Close()
+}
+
 func defaultOkCodes(method string) []int {
 	switch {
 	case method == "GET":
@@ -225,6 +225,57 @@ func (jt *JSONRFC3339NoZ) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// iso8601Layouts are the variants of ISO-8601 used for timestamps by
+// OpenStack services: with or without fractional seconds, with or without a
+// time zone, and with a space or a "T" between the date and the time.
+var iso8601Layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// ParseISO8601 parses a timestamp in any of the ISO-8601 variants emitted by
+// OpenStack services. Timestamps without a time zone are assumed to be in UTC.
+func ParseISO8601(s string) (time.Time, error) {
+	var err error
+	for _, layout := range iso8601Layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// JSONISO8601 is a time.Time which is decoded from any of the ISO-8601 variants
+// accepted by ParseISO8601. An empty or null value is decoded as the zero
+// time.
+//
+// Resource packages use it to decode timestamps into the time.Time fields of
+// their results, from the UnmarshalJSON method of the result type. Go promotes
+// that method to the structs which embed the result type, so such a struct
+// must decode the fields it adds itself, or they are left empty.
+type JSONISO8601 time.Time
+
+func (jt *JSONISO8601) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*jt = JSONISO8601(time.Time{})
+		return nil
+	}
+	t, err := ParseISO8601(*s)
+	if err != nil {
+		return err
+	}
+	*jt = JSONISO8601(t)
+	return nil
+}
+
 /*
 Link is an internal type to be used in packages of collection resources that are
 paginated in a certain way.
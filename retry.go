@@ -0,0 +1,164 @@
+package gophercloud
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether, and after how long, a failed request issued by
+// a ProviderClient is sent again. Set a ProviderClient's RetryPolicy field to
+// enable retries; a nil RetryPolicy never retries.
+type RetryPolicy interface {
+	// Retry is called after the attempt-th attempt at a request has failed.
+	// resp is the response received, if any, and err is the error returned for
+	// the attempt. Retry returns the delay to wait before the next attempt, and
+	// whether another attempt should be made at all.
+	Retry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// DefaultRetryCodes are the HTTP status codes retried by a BackoffRetryPolicy
+// that doesn't set RetryCodes.
+var DefaultRetryCodes = []int{429, 500, 502, 503, 504}
+
+// BackoffRetryPolicy is a RetryPolicy which retries transient failures with
+// an exponential backoff and jitter.
+//
+// Responses with a 429 or 503 status code were rejected before they were
+// processed, so they are retried for every HTTP method. Other status codes
+// and network errors are only retried for idempotent methods (GET, HEAD, PUT,
+// DELETE and OPTIONS), unless RetryNonIdempotent is set.
+//
+// If a response carries a Retry-After header, its value is used as the delay
+// instead of the computed backoff.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt. Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. It doubles on every
+	// subsequent retry. It defaults to one second.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including one requested by
+	// a Retry-After header. Zero means no cap.
+	MaxDelay time.Duration
+
+	// RetryCodes lists the HTTP status codes that are retried. It defaults to
+	// DefaultRetryCodes.
+	RetryCodes []int
+
+	// RetryNonIdempotent allows non-idempotent requests, such as POST, to be
+	// retried on every status code in RetryCodes and on network errors.
+	RetryNonIdempotent bool
+}
+
+// Retry satisfies the RetryPolicy interface.
+func (p BackoffRetryPolicy) Retry(method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	idempotent := p.RetryNonIdempotent || isIdempotent(method)
+
+	if resp == nil {
+		// Only errors returned by the transport are worth retrying. Others,
+		// such as a malformed response body, will fail again.
+		if _, ok := err.(*url.Error); !ok || !idempotent {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	codes := p.RetryCodes
+	if codes == nil {
+		codes = DefaultRetryCodes
+	}
+
+	var retryable bool
+	for _, code := range codes {
+		if resp.StatusCode == code {
+			retryable = true
+			break
+		}
+	}
+	if !retryable {
+		return 0, false
+	}
+
+	if !idempotent && resp.StatusCode != 429 && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+		if p.MaxDelay > 0 && delay > p.MaxDelay {
+			delay = p.MaxDelay
+		}
+		return delay, true
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff returns the delay before the retry following the given attempt:
+// a random duration between half and all of BaseDelay * 2^(attempt-1).
+func (p BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	for i := 1; i < attempt; i++ {
+		// Stop doubling before the delay overflows.
+		if delay > math.MaxInt64/2 {
+			delay = math.MaxInt64
+			break
+		}
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		delay := t.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+		return true
+	}
+	return false
+}
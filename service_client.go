@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -21,7 +22,47 @@ type ServiceClient struct {
 	// as-is, instead.
 	ResourceBase string
 
+	// Type is the type of the service, as found in the service catalog (e.g.
+	// "compute"). It is set by the provider's service client factory functions.
+	Type string
+
+	// Microversion is the microversion of the service's API requests are made
+	// with. If it is set, and the service supports microversions, it is sent in
+	// the OpenStack-API-Version header, as well as in the service's legacy
+	// header if it has one, such as X-OpenStack-Nova-API-Version.
 	Microversion string
+
+	// BeforeRequest hooks are called after the ProviderClient's BeforeRequest
+	// hooks for every request issued through this ServiceClient.
+	BeforeRequest []BeforeRequestHook
+
+	// AfterResponse hooks are called after the ProviderClient's AfterResponse
+	// hooks for every request issued through this ServiceClient.
+	AfterResponse []AfterResponseHook
+
+	// ctx, if set, is the context of every request issued through this
+	// ServiceClient. See WithContext.
+	ctx context.Context
+}
+
+// WithContext returns a shallow copy of the ServiceClient whose requests are
+// bound to ctx. The copy shares its ProviderClient, and therefore its token
+// and endpoint locator, with the original. Passing the copy to any resource
+// function lets ctx cancel, or set a deadline on, the calls it makes.
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	c := *client
+	c.ctx = ctx
+	return &c
+}
+
+// Context returns the context used for requests issued through this
+// ServiceClient: the one given to WithContext, if any, or else
+// context.Background.
+func (client *ServiceClient) Context() context.Context {
+	if client.ctx != nil {
+		return client.ctx
+	}
+	return context.Background()
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -37,6 +78,32 @@ func (client *ServiceClient) ServiceURL(parts ...string) string {
 	return client.ResourceBaseURL() + strings.Join(parts, "/")
 }
 
+// Request calls the ProviderClient's `RequestWithContext` with the
+// ServiceClient's Context and hooks. The request's context records the
+// ServiceClient's Type, see ServiceTypeFromContext.
+func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	options.beforeRequest = client.BeforeRequest
+	options.afterResponse = client.AfterResponse
+	ctx := client.Context()
+	if client.Type != "" {
+		ctx = context.WithValue(ctx, serviceTypeKey{}, client.Type)
+	}
+	return client.RequestWithContext(ctx, method, url, options)
+}
+
+// serviceTypeKey is the context key under which the type of the ServiceClient
+// that issued a request is stored.
+type serviceTypeKey struct{}
+
+// ServiceTypeFromContext returns the type of the ServiceClient that issued the
+// request a context belongs to, such as "compute". Hooks can call it with the
+// context of the http.Request they receive. It returns "" for requests that
+// weren't issued through a ServiceClient with a Type.
+func ServiceTypeFromContext(ctx context.Context) string {
+	t, _ := ctx.Value(serviceTypeKey{}).(string)
+	return t
+}
+
 // Get calls `Request` with the "GET" HTTP verb.
 func (client *ServiceClient) Get(url string, JSONResponse interface{}, opts *RequestOpts) (*http.Response, error) {
 	if opts == nil {
@@ -49,7 +116,7 @@ func (client *ServiceClient) Get(url string, JSONResponse interface{}, opts *Req
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("GET", url, opts)
 }
@@ -73,7 +140,7 @@ func (client *ServiceClient) Post(url string, JSONBody interface{}, JSONResponse
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("POST", url, opts)
 }
@@ -97,7 +164,7 @@ func (client *ServiceClient) Put(url string, JSONBody interface{}, JSONResponse
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("PUT", url, opts)
 }
@@ -121,7 +188,7 @@ func (client *ServiceClient) Patch(url string, JSONBody interface{}, JSONRespons
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("PATCH", url, opts)
 }
@@ -135,7 +202,7 @@ func (client *ServiceClient) Delete(url string, opts *RequestOpts) (*http.Respon
 	if opts.MoreHeaders == nil {
 		opts.MoreHeaders = make(map[string]string)
 	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("DELETE", url, opts)
 }
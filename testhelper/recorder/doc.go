@@ -0,0 +1,32 @@
+/*
+Package recorder records the HTTP interactions of a ProviderClient with a
+cloud into cassette files, and replays them, so that tests can run offline
+against the responses of a real cloud.
+
+To record, wrap the transport of the ProviderClient's HTTPClient in a
+Recorder before authenticating, and save the cassette once done:
+
+	rec := recorder.NewRecorder(nil)
+	provider, _ := openstack.NewClient(authOpts.IdentityEndpoint)
+	provider.HTTPClient.Transport = rec
+	openstack.Authenticate(provider, authOpts)
+	// ... make requests ...
+	rec.Save("testdata/servers.json")
+
+Tokens, passwords and other secrets are replaced by "***" before they are
+recorded.
+
+To replay, load the cassette and serve it with a Replayer. The authentication
+requests, including the service catalog, are replayed like any other request,
+so the ProviderClient is set up in the same way:
+
+	cassette, _ := recorder.LoadCassette("testdata/servers.json")
+	provider, _ := openstack.NewClient(authOpts.IdentityEndpoint)
+	provider.HTTPClient.Transport = recorder.NewReplayer(cassette)
+	openstack.Authenticate(provider, authOpts)
+
+A Replayer answers each request with the first interaction not replayed yet
+that has the same method and URL, so interactions with the same resource are
+replayed in the order they were recorded.
+*/
+package recorder
@@ -0,0 +1,277 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Cassette is a sequence of recorded HTTP interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded request or response body. It is stored as text when it is
+// valid UTF-8, and encoded in base64 otherwise.
+type Body []byte
+
+// MarshalJSON satisfies the json.Marshaler interface.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+
+	var encoded struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	if err != nil {
+		return err
+	}
+	*b = Body(decoded)
+	return nil
+}
+
+// LoadCassette reads a cassette from a file written by Save.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("Unable to read cassette %s: %s", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to a file, as indented JSON.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Recorder is an http.RoundTripper which sends requests through another
+// RoundTripper and records them, along with their responses.
+type Recorder struct {
+	// Transport sends the requests. It defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mut      sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder which sends requests through transport. A
+// nil transport selects http.DefaultTransport.
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	return &Recorder{Transport: transport}
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	i := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: gophercloud.RedactHeader(req.Header),
+			Body:   scrub(req.Header, reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     gophercloud.RedactHeader(resp.Header),
+			Body:       scrub(resp.Header, respBody),
+		},
+	}
+
+	r.mut.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, i)
+	r.mut.Unlock()
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	c := &Cassette{Interactions: make([]Interaction, len(r.cassette.Interactions))}
+	copy(c.Interactions, r.cassette.Interactions)
+	return c
+}
+
+// Save writes the interactions recorded so far to a file.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// scrub removes the secrets from a JSON body. Other bodies are recorded as
+// they are.
+func scrub(h http.Header, body []byte) Body {
+	if len(body) == 0 {
+		return nil
+	}
+	if strings.HasPrefix(h.Get("Content-Type"), "application/json") {
+		if scrubbed := gophercloud.RedactBody(body); scrubbed != nil {
+			return Body(scrubbed)
+		}
+	}
+	return Body(body)
+}
+
+// Replayer is an http.RoundTripper which answers requests with the responses
+// of a Cassette, without sending them.
+type Replayer struct {
+	mut      sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewReplayer returns a Replayer serving the interactions of c.
+func NewReplayer(c *Cassette) *Replayer {
+	return &Replayer{
+		cassette: c,
+		replayed: make([]bool, len(c.Interactions)),
+	}
+}
+
+// ErrNoInteraction is returned by a Replayer when the cassette doesn't hold
+// an interaction, which wasn't replayed yet, for a request.
+type ErrNoInteraction struct {
+	gophercloud.BaseError
+	Method string
+	URL    string
+}
+
+func (e ErrNoInteraction) Error() string {
+	return fmt.Sprintf("No recorded interaction left for %s %s", e.Method, e.URL)
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	url := req.URL.String()
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	for n, i := range r.cassette.Interactions {
+		if r.replayed[n] || i.Request.Method != req.Method || i.Request.URL != url {
+			continue
+		}
+		r.replayed[n] = true
+
+		header := make(http.Header, len(i.Response.Header))
+		for k, v := range i.Response.Header {
+			header[k] = append([]string(nil), v...)
+		}
+		// The recorded body may be shorter or longer than the original one,
+		// once secrets are scrubbed from it.
+		header.Del("Content-Length")
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, ErrNoInteraction{Method: req.Method, URL: url}
+}
+
+// Remaining returns the number of interactions which weren't replayed yet.
+func (r *Replayer) Remaining() int {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	remaining := 0
+	for _, replayed := range r.replayed {
+		if !replayed {
+			remaining++
+		}
+	}
+	return remaining
+}
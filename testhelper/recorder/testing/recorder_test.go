@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/recorder"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	th.SetupHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-Subject-Token", "real-token")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "2030-01-01T00:00:00Z"}}`)
+	})
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", "real-token")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"servers": [{"id": "1234"}]}`)
+	})
+
+	dir, err := ioutil.TempDir("", "recorder")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+
+	// Record the interactions with the server.
+	rec := recorder.NewRecorder(nil)
+	p := &gophercloud.ProviderClient{HTTPClient: http.Client{Transport: rec}}
+	exchange(t, p, th.Endpoint())
+	th.AssertNoErr(t, rec.Save(path))
+
+	endpoint := th.Endpoint()
+	th.TeardownHTTP()
+
+	cassette, err := recorder.LoadCassette(path)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(cassette.Interactions))
+
+	auth := cassette.Interactions[0]
+	th.AssertEquals(t, "POST", auth.Request.Method)
+	th.AssertEquals(t, endpoint+"auth/tokens", auth.Request.URL)
+	th.AssertJSONEquals(t, `{"auth": {"identity": {"password": {"user": {"name": "me", "password": "***"}}}}}`, json.RawMessage(auth.Request.Body))
+	th.AssertEquals(t, http.StatusCreated, auth.Response.StatusCode)
+	th.AssertEquals(t, "***", auth.Response.Header.Get("X-Subject-Token"))
+	th.AssertEquals(t, "***", cassette.Interactions[1].Request.Header.Get("X-Auth-Token"))
+
+	// Replay them, while the server is gone.
+	replayer := recorder.NewReplayer(cassette)
+	p = &gophercloud.ProviderClient{HTTPClient: http.Client{Transport: replayer}}
+	exchange(t, p, endpoint)
+	th.AssertEquals(t, 0, replayer.Remaining())
+
+	// Every interaction is only replayed once.
+	_, err = p.Request("GET", endpoint+"servers", &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("Expected an error once the cassette is exhausted")
+	}
+}
+
+// exchange authenticates, then lists servers.
+func exchange(t *testing.T, p *gophercloud.ProviderClient, endpoint string) {
+	resp, err := p.Request("POST", endpoint+"auth/tokens", &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"password": map[string]interface{}{
+						"user": map[string]interface{}{
+							"name":     "me",
+							"password": "swordfish",
+						},
+					},
+				},
+			},
+		},
+		OkCodes: []int{201},
+	})
+	th.AssertNoErr(t, err)
+	p.SetToken(resp.Header.Get("X-Subject-Token"))
+
+	var servers map[string]interface{}
+	_, err = p.Request("GET", endpoint+"servers", &gophercloud.RequestOpts{
+		JSONResponse: &servers,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(servers["servers"].([]interface{})))
+}
@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestFaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	tests := []struct {
+		status int
+		body   string
+		fault  gophercloud.Fault
+	}{
+		{
+			status: 403,
+			body:   `{"forbidden": {"code": 403, "message": "Quota exceeded for instances"}}`,
+			fault:  gophercloud.Fault{Code: 403, Type: "forbidden", Message: "Quota exceeded for instances"},
+		},
+		{
+			status: 404,
+			body:   `{"NeutronError": {"type": "PortNotFound", "message": "Port 42 could not be found.", "detail": ""}}`,
+			fault:  gophercloud.Fault{Type: "PortNotFound", Message: "Port 42 could not be found."},
+		},
+		{
+			status: 401,
+			body:   `{"error": {"code": 401, "title": "Unauthorized", "message": "The request you have made requires authentication."}}`,
+			fault:  gophercloud.Fault{Code: 401, Type: "Unauthorized", Message: "The request you have made requires authentication."},
+		},
+		{
+			status: 400,
+			body:   `{"code": 400, "title": "Bad Request", "explanation": "The server could not comply with the request.", "error": {"type": "StackValidationFailed", "message": "Property error", "traceback": null}}`,
+			fault:  gophercloud.Fault{Code: 400, Type: "StackValidationFailed", Message: "Property error"},
+		},
+	}
+
+	for i, test := range tests {
+		path := fmt.Sprintf("/fault%d", i)
+		status, body := test.status, test.body
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Openstack-Request-Id", "req-1234")
+			w.WriteHeader(status)
+			fmt.Fprint(w, body)
+		})
+
+		_, err := client.ServiceClient().Get(client.ServiceClient().ServiceURL(path[1:]), nil, nil)
+
+		var respErr gophercloud.ErrUnexpectedResponseCode
+		if !errors.As(err, &respErr) {
+			t.Fatalf("Expected an ErrUnexpectedResponseCode, got %#v", err)
+		}
+		th.AssertEquals(t, "req-1234", respErr.RequestID)
+
+		var fault *gophercloud.Fault
+		if !errors.As(err, &fault) {
+			t.Fatalf("Expected a Fault, got %#v", err)
+		}
+
+		expected := test.fault
+		expected.StatusCode = test.status
+		expected.RequestID = "req-1234"
+		th.AssertDeepEquals(t, expected, *fault)
+	}
+}
+
+func TestFaultNotRecognized(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `404 Not Found`)
+	})
+
+	_, err := client.ServiceClient().Get(client.ServiceClient().ServiceURL("route"), nil, nil)
+
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected an ErrDefault404, got %#v", err)
+	}
+
+	var fault *gophercloud.Fault
+	if errors.As(err, &fault) {
+		t.Fatalf("Expected no Fault, got %#v", fault)
+	}
+}
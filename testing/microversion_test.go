@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestMicroversionHeaders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var header http.Header
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		fmt.Fprintf(w, `{}`)
+	})
+
+	sc := client.ServiceClient()
+	sc.Microversion = "2.53"
+
+	sc.Type = "compute"
+	_, err := sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "compute 2.53", header.Get("OpenStack-API-Version"))
+	th.AssertEquals(t, "2.53", header.Get("X-OpenStack-Nova-API-Version"))
+
+	sc.Type = "volumev3"
+	sc.Microversion = "3.27"
+	_, err = sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "volume 3.27", header.Get("OpenStack-API-Version"))
+	th.AssertEquals(t, "", header.Get("X-OpenStack-Nova-API-Version"))
+
+	sc.Type = "network"
+	_, err = sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", header.Get("OpenStack-API-Version"))
+	th.AssertEquals(t, "", header.Get("X-OpenStack-Nova-API-Version"))
+
+	sc.Type = "compute"
+	sc.Microversion = ""
+	_, err = sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", header.Get("OpenStack-API-Version"))
+	th.AssertEquals(t, "", header.Get("X-OpenStack-Nova-API-Version"))
+}
+
+func TestResponseMicroversion(t *testing.T) {
+	h := http.Header{}
+	h.Set("OpenStack-API-Version", "volume 3.27")
+	th.CheckEquals(t, "3.27", gophercloud.ResponseMicroversion("volumev3", h))
+	th.CheckEquals(t, "", gophercloud.ResponseMicroversion("compute", h))
+
+	h = http.Header{}
+	h.Set("X-OpenStack-Nova-API-Version", "2.1")
+	th.CheckEquals(t, "2.1", gophercloud.ResponseMicroversion("compute", h))
+}
+
+func TestCompareMicroversions(t *testing.T) {
+	c, err := gophercloud.CompareMicroversions("2.9", "2.10")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, -1, c)
+
+	c, err = gophercloud.CompareMicroversions("3.0", "2.79")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, c)
+
+	c, err = gophercloud.CompareMicroversions("2.1", "2.1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, c)
+
+	_, err = gophercloud.CompareMicroversions("2", "2.1")
+	if err == nil {
+		t.Fatal("Expected an error from a malformed microversion")
+	}
+}
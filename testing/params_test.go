@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -80,6 +81,20 @@ func TestBuildQueryString(t *testing.T) {
 	}
 }
 
+func TestBuildQueryStringPointers(t *testing.T) {
+	opts := struct {
+		Enabled  *bool   `q:"enabled"`
+		IsDomain *bool   `q:"is_domain"`
+		Name     *string `q:"name"`
+	}{
+		Enabled: gophercloud.Disabled,
+	}
+	expected := &url.URL{RawQuery: "enabled=false"}
+	actual, err := gophercloud.BuildQueryString(opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
 func TestBuildHeaders(t *testing.T) {
 	testStruct := struct {
 		Accept string `h:"Accept"`
@@ -248,3 +263,27 @@ func TestBuildRequestBody(t *testing.T) {
 		th.AssertDeepEquals(t, reflect.TypeOf(failCase.expected), reflect.TypeOf(err))
 	}
 }
+
+func TestBuildRequestBodyTime(t *testing.T) {
+	type CreateOpts struct {
+		Name      string     `json:"name" required:"true"`
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	}
+
+	expiresAt := time.Date(2017, 2, 3, 4, 5, 6, 0, time.UTC)
+	opts := CreateOpts{
+		Name:      "ci",
+		ExpiresAt: &expiresAt,
+	}
+
+	expected := map[string]interface{}{
+		"application_credential": map[string]interface{}{
+			"name":       "ci",
+			"expires_at": "2017-02-03T04:05:06Z",
+		},
+	}
+
+	actual, err := gophercloud.BuildRequestBody(opts, "application_credential")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, actual)
+}
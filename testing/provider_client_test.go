@@ -1,10 +1,22 @@
 package testing
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestAuthenticatedHeaders(t *testing.T) {
@@ -34,3 +46,694 @@ func TestUserAgent(t *testing.T) {
 	actual = p.UserAgent.Join()
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestRequestWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "OK")
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &gophercloud.ProviderClient{}
+
+	res, err := p.RequestWithContext(ctx, "GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, http.StatusOK, res.StatusCode)
+	res.Body.Close()
+
+	cancel()
+	_, err = p.RequestWithContext(ctx, "GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expected an error from a request with a cancelled context")
+	}
+}
+
+func TestRequestWithContextDeadline(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	p := &gophercloud.ProviderClient{}
+	_, err := p.RequestWithContext(ctx, "GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expected an error from a request that exceeded its deadline")
+	}
+	th.AssertEquals(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestRequestRetry(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxAttempts: 3},
+	}
+
+	body := strings.NewReader("data")
+	_, err := p.Request("POST", ts.URL, &gophercloud.RequestOpts{
+		RawBody: body,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, attempts)
+}
+
+func TestRequestRetryExhausted(t *testing.T) {
+	var attempts int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{
+			MaxAttempts: 2,
+			BaseDelay:   time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault500); !ok {
+		t.Fatalf("expected ErrDefault500, got %#v", err)
+	}
+	th.AssertEquals(t, 2, attempts)
+
+	// A 500 response to a non-idempotent request is not retried.
+	attempts = 0
+	_, err = p.Request("POST", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault500); !ok {
+		t.Fatalf("expected ErrDefault500, got %#v", err)
+	}
+	th.AssertEquals(t, 1, attempts)
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := gophercloud.BackoffRetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Second,
+		MaxDelay:    3 * time.Second,
+	}
+
+	resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+	delay, ok := p.Retry("POST", 1, resp, nil)
+	th.AssertEquals(t, true, ok)
+	if delay < 500*time.Millisecond || delay > time.Second {
+		t.Errorf("unexpected delay for the first retry: %s", delay)
+	}
+
+	delay, ok = p.Retry("GET", 4, resp, nil)
+	th.AssertEquals(t, true, ok)
+	if delay < 1500*time.Millisecond || delay > 3*time.Second {
+		t.Errorf("unexpected capped delay: %s", delay)
+	}
+
+	resp.Header.Set("Retry-After", "2")
+	delay, ok = p.Retry("GET", 1, resp, nil)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 2*time.Second, delay)
+
+	_, ok = p.Retry("GET", 5, resp, nil)
+	th.AssertEquals(t, false, ok)
+
+	_, ok = p.Retry("GET", 1, &http.Response{StatusCode: 404}, nil)
+	th.AssertEquals(t, false, ok)
+}
+
+func TestBackoffRetryPolicyUncapped(t *testing.T) {
+	p := gophercloud.BackoffRetryPolicy{
+		MaxAttempts: 100,
+		BaseDelay:   time.Second,
+	}
+
+	resp := &http.Response{StatusCode: 503, Header: http.Header{}}
+	for attempt := 1; attempt < p.MaxAttempts; attempt++ {
+		delay, ok := p.Retry("GET", attempt, resp, nil)
+		th.AssertEquals(t, true, ok)
+		if delay <= 0 {
+			t.Fatalf("unexpected delay for attempt %d: %s", attempt, delay)
+		}
+	}
+}
+
+func TestConcurrentReauth(t *testing.T) {
+	var info = struct {
+		numreauths int
+		mut        *sync.RWMutex
+	}{
+		0,
+		new(sync.RWMutex),
+	}
+
+	prereauthTok := client.TokenID
+	postreauthTok := "12345678"
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(prereauthTok)
+	p.ReauthFunc = func(ctx context.Context) error {
+		time.Sleep(100 * time.Millisecond)
+		info.mut.Lock()
+		info.numreauths++
+		info.mut.Unlock()
+		p.SetToken(postreauthTok)
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != postreauthTok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{}`)
+	})
+
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+			if err != nil {
+				t.Errorf("got unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	th.AssertEquals(t, 1, info.numreauths)
+	th.AssertEquals(t, postreauthTok, p.Token())
+}
+
+func TestReauthEndLoop(t *testing.T) {
+	var numreauths int
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func(ctx context.Context) error {
+		numreauths++
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+	if _, ok := err.(*gophercloud.ErrErrorAfterReauthentication); !ok {
+		t.Fatalf("expected ErrErrorAfterReauthentication, got %#v", err)
+	}
+	th.AssertEquals(t, 1, numreauths)
+}
+
+type reauthKey struct{}
+
+func TestReauthWithRequestContext(t *testing.T) {
+	var reauthCtx context.Context
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func(ctx context.Context) error {
+		reauthCtx = ctx
+		p.SetToken("12345678")
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "12345678" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.WithValue(context.Background(), reauthKey{}, "request")
+	_, err := p.RequestWithContext(ctx, "GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "request", reauthCtx.Value(reauthKey{}))
+}
+
+func TestReauthAfterCancelledReauth(t *testing.T) {
+	var numreauths int
+	started := make(chan struct{})
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func(ctx context.Context) error {
+		numreauths++
+		if numreauths == 1 {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		p.SetToken("12345678")
+		return nil
+	}
+
+	// The first re-authentication is abandoned by the caller which started
+	// it. The caller waiting for it starts another one.
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		errs <- p.Reauthenticate(ctx, client.TokenID)
+	}()
+	<-started
+	go func() {
+		errs <- p.Reauthenticate(context.Background(), client.TokenID)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	err1, err2 := <-errs, <-errs
+	if (err1 == nil) == (err2 == nil) {
+		t.Fatalf("expected exactly one re-authentication to fail, got %v and %v", err1, err2)
+	}
+	th.AssertEquals(t, 2, numreauths)
+	th.AssertEquals(t, "12345678", p.Token())
+}
+
+func TestProactiveReauth(t *testing.T) {
+	var numreauths int
+	var renewedUntil time.Time
+
+	newExpiry := time.Now().Add(time.Hour)
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.SetTokenExpiresAt(time.Now().Add(30 * time.Second))
+	p.TokenRenewalMargin = time.Minute
+	p.ReauthFunc = func(ctx context.Context) error {
+		numreauths++
+		p.SetToken("12345678")
+		p.SetTokenExpiresAt(newExpiry)
+		return nil
+	}
+	p.OnReauth = func(expiresAt time.Time, err error) {
+		th.AssertNoErr(t, err)
+		renewedUntil = expiresAt
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "12345678")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := p.Request("POST", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+
+	th.AssertEquals(t, 1, numreauths)
+	th.AssertEquals(t, newExpiry, renewedUntil)
+}
+
+func TestProactiveReauthWithoutRenewal(t *testing.T) {
+	var numreauths int
+	var reauthErrs []error
+
+	expiresAt := time.Now().Add(30 * time.Second)
+
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.SetTokenExpiresAt(expiresAt)
+	p.TokenRenewalMargin = time.Minute
+	p.ReauthFunc = func(ctx context.Context) error {
+		numreauths++
+		if numreauths == 1 {
+			// The new token expires with the old one, as a token obtained
+			// from another token does.
+			p.SetToken("12345678")
+			return nil
+		}
+		return errors.New("identity service unavailable")
+	}
+	p.OnReauth = func(_ time.Time, err error) {
+		reauthErrs = append(reauthErrs, err)
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := p.Request("POST", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, 1, numreauths)
+
+	// Once the expiry changes, the token is renewed again, and the failure is
+	// reported.
+	p.SetTokenExpiresAt(expiresAt.Add(time.Second))
+	for i := 0; i < 3; i++ {
+		_, err := p.Request("POST", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, 2, numreauths)
+	th.AssertDeepEquals(t, []error{nil, errors.New("identity service unavailable")}, reauthErrs)
+}
+
+type recordingLogger struct {
+	logs []gophercloud.RequestLog
+}
+
+func (l *recordingLogger) LogRequest(r gophercloud.RequestLog) {
+	l.logs = append(l.logs, r)
+}
+
+func TestRequestLogging(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-Openstack-Request-Id", "req-1234")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"access": {"token": {"id": "secret-token"}, "user": {"id": "user-id"}}}`)
+	})
+
+	logger := new(recordingLogger)
+	p := &gophercloud.ProviderClient{
+		TokenID: client.TokenID,
+		Logger:  logger,
+		Debug:   true,
+	}
+
+	var actual map[string]interface{}
+	_, err := p.Request("POST", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"auth": map[string]interface{}{
+				"passwordCredentials": map[string]interface{}{
+					"username": "me",
+					"password": "swordfish",
+				},
+			},
+		},
+		JSONResponse: &actual,
+	})
+	th.AssertNoErr(t, err)
+
+	// The caller still receives the complete response body.
+	th.AssertEquals(t, "secret-token", actual["access"].(map[string]interface{})["token"].(map[string]interface{})["id"])
+
+	th.AssertEquals(t, 1, len(logger.logs))
+	l := logger.logs[0]
+	th.AssertEquals(t, "POST", l.Method)
+	th.AssertEquals(t, http.StatusCreated, l.StatusCode)
+	th.AssertEquals(t, "req-1234", l.RequestID)
+	th.AssertEquals(t, "***", l.RequestHeader.Get("X-Auth-Token"))
+	th.AssertJSONEquals(t, `{"auth": {"passwordCredentials": {"username": "me", "password": "***"}}}`, json.RawMessage(l.RequestBody))
+	th.AssertJSONEquals(t, `{"access": {"token": {"id": "***"}, "user": {"id": "user-id"}}}`, json.RawMessage(l.ResponseBody))
+}
+
+func TestRequestLoggingNestedSecrets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	logger := new(recordingLogger)
+	p := &gophercloud.ProviderClient{
+		Logger: logger,
+		Debug:  true,
+	}
+
+	_, err := p.Request("POST", fmt.Sprintf("%s/auth/tokens", th.Endpoint()), &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods": []string{"password"},
+					"password": map[string]interface{}{
+						"user": map[string]interface{}{
+							"name":     "me",
+							"password": "swordfish",
+						},
+					},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	// An object under a secret-sounding key is not redacted as a whole: only
+	// the secrets it holds are.
+	th.AssertEquals(t, 1, len(logger.logs))
+	th.AssertJSONEquals(t, `{"auth": {"identity": {"methods": ["password"], "password": {"user": {"name": "me", "password": "***"}}}}}`, json.RawMessage(logger.logs[0].RequestBody))
+}
+
+func TestRequestHooks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Openstack-Request-Id", "req-first-second")
+		w.Header().Add("X-Openstack-Request-Id", r.Header.Get("X-Openstack-Request-Id"))
+	})
+
+	var observed []string
+	p := &gophercloud.ProviderClient{
+		BeforeRequest: []gophercloud.BeforeRequestHook{
+			func(r *http.Request) error {
+				r.Header.Set("X-Openstack-Request-Id", "req-first")
+				return nil
+			},
+			func(r *http.Request) error {
+				r.Header.Set("X-Openstack-Request-Id", r.Header.Get("X-Openstack-Request-Id")+"-second")
+				return nil
+			},
+		},
+		AfterResponse: []gophercloud.AfterResponseHook{
+			func(r *http.Request, resp *http.Response, err error) {
+				if err != nil {
+					observed = append(observed, err.Error())
+					return
+				}
+				observed = append(observed, resp.Header.Get("X-Openstack-Request-Id"))
+			},
+		},
+	}
+
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"req-first-second"}, observed)
+
+	hookErr := fmt.Errorf("aborted")
+	p.BeforeRequest = []gophercloud.BeforeRequestHook{
+		func(r *http.Request) error {
+			return hookErr
+		},
+	}
+	_, err = p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &gophercloud.RequestOpts{})
+	th.AssertEquals(t, hookErr, err)
+
+	// The AfterResponse hooks are told about the failure.
+	th.AssertDeepEquals(t, []string{"req-first-second", "aborted"}, observed)
+}
+
+func TestRequestReusesConnections(t *testing.T) {
+	var mut sync.Mutex
+	var conns int
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json":
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"a": 1}`+"\n\n")
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"itemNotFound": {"message": "Not found", "code": 404}}`)
+		default:
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, "accepted")
+		}
+	}))
+	ts.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mut.Lock()
+			conns++
+			mut.Unlock()
+		}
+	}
+	ts.Start()
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{}
+	p.HTTPClient.Transport = gophercloud.NewTransport(gophercloud.TransportOpts{
+		MaxIdleConnsPerHost: 4,
+	})
+
+	for i := 0; i < 3; i++ {
+		var actual map[string]interface{}
+		_, err := p.Request("GET", ts.URL+"/json", &gophercloud.RequestOpts{
+			JSONResponse: &actual,
+		})
+		th.AssertNoErr(t, err)
+
+		_, err = p.Request("DELETE", ts.URL+"/other", &gophercloud.RequestOpts{
+			DrainResponseBody: true,
+		})
+		th.AssertNoErr(t, err)
+
+		// A body left open is reusable once the caller has read and closed it.
+		resp, err := p.Request("POST", ts.URL+"/other", &gophercloud.RequestOpts{})
+		th.AssertNoErr(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "accepted", string(body))
+		resp.Body.Close()
+
+		_, err = p.Request("GET", ts.URL+"/missing", &gophercloud.RequestOpts{})
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			t.Fatalf("expected ErrDefault404, got %#v", err)
+		}
+	}
+
+	th.AssertEquals(t, 1, conns)
+}
+
+func TestNewTransportHTTP2(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Proto)
+	}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{}
+	p.HTTPClient.Transport = gophercloud.NewTransport(gophercloud.TransportOpts{
+		TLSClientConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig,
+	})
+
+	resp, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "HTTP/2.0", string(body))
+}
+
+func TestDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"servers": [{"id": "1234", "name": "web"}]}`)
+	})
+
+	plan := new(gophercloud.DryRun)
+	p := &gophercloud.ProviderClient{
+		TokenID: client.TokenID,
+		DryRun:  plan,
+	}
+
+	// Lookups are still sent.
+	var list map[string]interface{}
+	_, err := p.Request("GET", th.Endpoint()+"servers", &gophercloud.RequestOpts{
+		JSONResponse: &list,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list["servers"].([]interface{})))
+
+	// Changes are captured, and answered with a synthetic response and
+	// ErrDryRun.
+	var created map[string]interface{}
+	resp, err := p.Request("POST", th.Endpoint()+"servers", &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"server": map[string]interface{}{
+				"name":      "db",
+				"adminPass": "swordfish",
+			},
+		},
+		JSONResponse: &created,
+		OkCodes:      []int{202},
+	})
+	th.AssertEquals(t, gophercloud.ErrDryRun, err)
+	th.AssertEquals(t, 202, resp.StatusCode)
+	th.AssertEquals(t, 0, len(created))
+
+	_, err = p.Request("DELETE", th.Endpoint()+"servers/1234", &gophercloud.RequestOpts{})
+	th.AssertEquals(t, gophercloud.ErrDryRun, err)
+
+	requests := plan.Requests()
+	th.AssertEquals(t, 2, len(requests))
+	th.AssertEquals(t, "POST", requests[0].Method)
+	th.AssertEquals(t, th.Endpoint()+"servers", requests[0].URL)
+	th.AssertEquals(t, "***", requests[0].Header.Get("X-Auth-Token"))
+	th.AssertJSONEquals(t, `{"server": {"name": "db", "adminPass": "***"}}`, json.RawMessage(requests[0].Body))
+	th.AssertEquals(t, "DELETE", requests[1].Method)
+	th.AssertEquals(t, th.Endpoint()+"servers/1234", requests[1].URL)
+
+	plan.Reset()
+	th.AssertEquals(t, 0, len(plan.Requests()))
+}
+
+func TestWrapEndpointLocator(t *testing.T) {
+	locator := func(url string) gophercloud.EndpointLocator {
+		return func(gophercloud.EndpointOpts) (string, error) {
+			return url, nil
+		}
+	}
+
+	p := new(gophercloud.ProviderClient)
+	p.SetEndpointLocator(locator("https://catalog-1.example.com/"))
+	p.WrapEndpointLocator(func(next gophercloud.EndpointLocator) gophercloud.EndpointLocator {
+		return func(opts gophercloud.EndpointOpts) (string, error) {
+			if opts.Type == "compute" {
+				return "https://compute.example.com/", nil
+			}
+			return next(opts)
+		}
+	})
+
+	check := func(catalogURL string) {
+		url, err := p.LocateEndpoint(gophercloud.EndpointOpts{Type: "compute"})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "https://compute.example.com/", url)
+		url, err = p.LocateEndpoint(gophercloud.EndpointOpts{Type: "network"})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, catalogURL, url)
+	}
+	check("https://catalog-1.example.com/")
+
+	// The locator copied on re-authentication is wrapped too.
+	other := new(gophercloud.ProviderClient)
+	other.SetEndpointLocator(locator("https://catalog-2.example.com/"))
+	p.CopyTokenFrom(other)
+	check("https://catalog-2.example.com/")
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestParseISO8601(t *testing.T) {
+	expected := time.Date(2017, 3, 1, 12, 34, 56, 0, time.UTC)
+	for _, s := range []string{
+		"2017-03-01T12:34:56Z",
+		"2017-03-01T12:34:56+00:00",
+		"2017-03-01T12:34:56+0000",
+		"2017-03-01T12:34:56",
+		"2017-03-01T12:34:56.000000",
+		"2017-03-01 12:34:56",
+	} {
+		actual, err := gophercloud.ParseISO8601(s)
+		th.AssertNoErr(t, err)
+		if !actual.Equal(expected) {
+			t.Errorf("Expected %s to be parsed as %s, got %s", s, expected, actual)
+		}
+	}
+
+	actual, err := gophercloud.ParseISO8601("2017-03-01T12:34:56.123456")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 123456000, actual.Nanosecond())
+
+	actual, err = gophercloud.ParseISO8601("2017-03-01T14:34:56+02:00")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, actual.Equal(expected))
+
+	_, err = gophercloud.ParseISO8601("yesterday")
+	if err == nil {
+		t.Fatal("Expected an error from an invalid timestamp")
+	}
+}
+
+func TestJSONISO8601(t *testing.T) {
+	var s struct {
+		Set   gophercloud.JSONISO8601 `json:"set"`
+		Empty gophercloud.JSONISO8601 `json:"empty"`
+		Null  gophercloud.JSONISO8601 `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"set": "2017-03-01T12:34:56.000000", "empty": "", "null": null}`), &s)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, time.Date(2017, 3, 1, 12, 34, 56, 0, time.UTC), time.Time(s.Set))
+	th.AssertEquals(t, true, time.Time(s.Empty).IsZero())
+	th.AssertEquals(t, true, time.Time(s.Null).IsZero())
+}
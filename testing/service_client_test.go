@@ -1,10 +1,14 @@
 package testing
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestServiceURL(t *testing.T) {
@@ -13,3 +17,56 @@ func TestServiceURL(t *testing.T) {
 	actual := c.ServiceURL("more", "parts", "here")
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestServiceClientWithContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{}`)
+	})
+
+	sc := client.ServiceClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	scCtx := sc.WithContext(ctx)
+	th.AssertEquals(t, sc.ProviderClient, scCtx.ProviderClient)
+
+	_, err := scCtx.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	cancel()
+	_, err = scCtx.Get(sc.ServiceURL("route"), nil, nil)
+	if err == nil {
+		t.Fatal("expected an error from a request with a cancelled context")
+	}
+
+	// The original ServiceClient is not affected by the cancellation.
+	_, err = sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+}
+
+func TestServiceClientHooks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Hook", "provider,service")
+	})
+
+	sc := client.ServiceClient()
+	sc.ProviderClient.BeforeRequest = []gophercloud.BeforeRequestHook{
+		func(r *http.Request) error {
+			r.Header.Set("X-Hook", "provider")
+			return nil
+		},
+	}
+	sc.BeforeRequest = []gophercloud.BeforeRequestHook{
+		func(r *http.Request) error {
+			r.Header.Set("X-Hook", r.Header.Get("X-Hook")+",service")
+			return nil
+		},
+	}
+
+	_, err := sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+}
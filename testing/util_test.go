@@ -1,10 +1,13 @@
 package testing
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -17,6 +20,16 @@ func TestWaitFor(t *testing.T) {
 	th.CheckNoErr(t, err)
 }
 
+func TestWaitForContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := gophercloud.WaitForContext(ctx, func() (bool, error) {
+		return false, nil
+	})
+	th.CheckEquals(t, context.Canceled, err)
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
@@ -84,3 +97,71 @@ func TestNormalizePathURL(t *testing.T) {
 	th.CheckEquals(t, expected, result)
 
 }
+
+func TestWait(t *testing.T) {
+	polls := 0
+	last, err := gophercloud.Wait(context.Background(), gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+	}, func(context.Context) (interface{}, bool, error) {
+		polls++
+		return polls, polls == 3, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, polls)
+	th.AssertEquals(t, 3, last)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	polls := 0
+	last, err := gophercloud.Wait(context.Background(), gophercloud.WaitOpts{
+		Interval:    time.Millisecond,
+		MaxInterval: 10 * time.Millisecond,
+		Timeout:     50 * time.Millisecond,
+	}, func(context.Context) (interface{}, bool, error) {
+		polls++
+		return "BUILD", false, nil
+	})
+
+	timeoutErr, ok := err.(gophercloud.ErrWaitTimeout)
+	if !ok {
+		t.Fatalf("Expected an ErrWaitTimeout, got %#v", err)
+	}
+	th.AssertEquals(t, context.DeadlineExceeded, timeoutErr.Err)
+	th.AssertEquals(t, "BUILD", timeoutErr.Last)
+	th.AssertEquals(t, "BUILD", last)
+	if polls < 2 {
+		t.Errorf("Expected several polls, got %d", polls)
+	}
+}
+
+func TestWaitError(t *testing.T) {
+	failed := gophercloud.ErrResourceFailed{ResourceType: "server", ID: "1234", Status: "ERROR"}
+	last, err := gophercloud.Wait(context.Background(), gophercloud.WaitOpts{}, func(context.Context) (interface{}, bool, error) {
+		return "ERROR", false, failed
+	})
+	th.AssertEquals(t, failed, err)
+	th.AssertEquals(t, "ERROR", last)
+}
+
+func TestWaitTimeoutDuringPoll(t *testing.T) {
+	polls := 0
+	last, err := gophercloud.Wait(context.Background(), gophercloud.WaitOpts{
+		Interval: time.Millisecond,
+		Timeout:  50 * time.Millisecond,
+	}, func(ctx context.Context) (interface{}, bool, error) {
+		polls++
+		if polls == 1 {
+			return "BUILD", false, nil
+		}
+		<-ctx.Done()
+		return nil, false, fmt.Errorf("request interrupted: %s", ctx.Err())
+	})
+
+	timeoutErr, ok := err.(gophercloud.ErrWaitTimeout)
+	if !ok {
+		t.Fatalf("Expected an ErrWaitTimeout, got %#v", err)
+	}
+	th.AssertEquals(t, context.DeadlineExceeded, timeoutErr.Err)
+	th.AssertEquals(t, "BUILD", timeoutErr.Last)
+	th.AssertEquals(t, "BUILD", last)
+}
@@ -0,0 +1,101 @@
+package gophercloud
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransportOpts configures the HTTP transport returned by NewTransport. Zero
+// values select the defaults of net/http's DefaultTransport, unless stated
+// otherwise.
+type TransportOpts struct {
+	// MaxIdleConns is the maximum number of idle connections kept open across
+	// all hosts.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept open
+	// to each host. It defaults to 2, which is low for a client that issues
+	// concurrent requests to a handful of API endpoints.
+	MaxIdleConnsPerHost int
+
+	// MaxConnsPerHost limits the total number of connections to each host.
+	// Zero means no limit.
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept open.
+	IdleConnTimeout time.Duration
+
+	// DialTimeout is the maximum amount of time a dial waits for a connection
+	// to be established.
+	DialTimeout time.Duration
+
+	// KeepAlive is the interval between TCP keep-alive probes.
+	KeepAlive time.Duration
+
+	// TLSHandshakeTimeout is the maximum amount of time spent on a TLS
+	// handshake.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout is the maximum amount of time spent waiting for
+	// the headers of a response once a request has been written. Zero means
+	// no timeout.
+	ResponseHeaderTimeout time.Duration
+
+	// TLSClientConfig is the TLS configuration used by the transport. Setting
+	// it does not disable HTTP/2.
+	TLSClientConfig *tls.Config
+}
+
+// NewTransport returns an HTTP transport which reuses connections according
+// to opts. Set it as the Transport of a ProviderClient's HTTPClient:
+//
+//	provider.HTTPClient.Transport = gophercloud.NewTransport(gophercloud.TransportOpts{
+//		MaxIdleConnsPerHost: 32,
+//	})
+//
+// Proxies are configured from the environment and HTTP/2 is negotiated over
+// TLS, like in net/http's DefaultTransport, including when TLSClientConfig is
+// set.
+func NewTransport(opts TransportOpts) *http.Transport {
+	defaults := http.DefaultTransport.(*http.Transport)
+
+	dialTimeout := opts.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = 30 * time.Second
+	}
+	keepAlive := opts.KeepAlive
+	if keepAlive == 0 {
+		keepAlive = 30 * time.Second
+	}
+
+	t := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: keepAlive,
+		}).DialContext,
+		MaxIdleConns:          defaults.MaxIdleConns,
+		MaxIdleConnsPerHost:   opts.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       opts.MaxConnsPerHost,
+		IdleConnTimeout:       defaults.IdleConnTimeout,
+		TLSHandshakeTimeout:   defaults.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: opts.ResponseHeaderTimeout,
+		ExpectContinueTimeout: defaults.ExpectContinueTimeout,
+		TLSClientConfig:       opts.TLSClientConfig,
+		ForceAttemptHTTP2:     true,
+	}
+
+	if opts.MaxIdleConns != 0 {
+		t.MaxIdleConns = opts.MaxIdleConns
+	}
+	if opts.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = opts.IdleConnTimeout
+	}
+	if opts.TLSHandshakeTimeout != 0 {
+		t.TLSHandshakeTimeout = opts.TLSHandshakeTimeout
+	}
+
+	return t
+}
@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"path/filepath"
@@ -13,14 +14,30 @@ import (
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
-	start := time.Now().Second()
+	ctx := context.Background()
+	if timeout >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+	}
+
+	err := WaitForContext(ctx, predicate)
+	if err == context.DeadlineExceeded {
+		return errors.New("A timeout occurred")
+	}
+	return err
+}
+
+// WaitForContext polls a predicate function, once per second, until it is
+// satisfied, it returns an error, or ctx is done. In the last case, the
+// context's error is returned.
+func WaitForContext(ctx context.Context, predicate func() (bool, error)) error {
 	for {
 		// Force a 1s sleep
-		time.Sleep(1 * time.Second)
-
-		// If a timeout is set, and that's been exceeded, shut it down
-		if timeout >= 0 && time.Now().Second()-start >= timeout {
-			return errors.New("A timeout occurred")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Second):
 		}
 
 		// Execute the function
@@ -34,6 +51,79 @@ func WaitFor(timeout int, predicate func() (bool, error)) error {
 	}
 }
 
+// WaitOpts configures how Wait polls a resource.
+type WaitOpts struct {
+	// Interval is the delay between two polls. It defaults to one second.
+	Interval time.Duration
+
+	// MaxInterval, if greater than Interval, makes the delay between two polls
+	// double after every poll, up to MaxInterval.
+	MaxInterval time.Duration
+
+	// Timeout, if set, bounds the duration of the wait, in addition to the
+	// deadline of the context.
+	Timeout time.Duration
+}
+
+// WaitFunc polls a resource once, issuing its requests with ctx, which is done
+// once the wait times out. It returns the resource observed, whether the wait
+// is over, and an error which ends the wait early, such as an
+// ErrResourceFailed if the resource reached a failure state.
+type WaitFunc func(ctx context.Context) (resource interface{}, done bool, err error)
+
+// Wait calls poll until it reports that the wait is over or returns an error,
+// starting immediately and then pausing between calls as configured by opts.
+// It returns the last resource observed by poll.
+//
+// If ctx is done or the timeout expires first, including while poll is running,
+// Wait returns an ErrWaitTimeout holding the last resource observed.
+func Wait(ctx context.Context, opts WaitOpts, poll WaitFunc) (interface{}, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var last interface{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return last, ErrWaitTimeout{Last: last, Err: err}
+		}
+
+		resource, done, err := poll(ctx)
+		if resource != nil {
+			last = resource
+		}
+		if err != nil && ctx.Err() != nil {
+			// The poll was most likely interrupted by the end of the wait.
+			return last, ErrWaitTimeout{Last: last, Err: ctx.Err()}
+		}
+		if err != nil || done {
+			return last, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ErrWaitTimeout{Last: last, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		if opts.MaxInterval > interval {
+			interval *= 2
+			if interval > opts.MaxInterval {
+				interval = opts.MaxInterval
+			}
+		}
+	}
+}
+
 // NormalizeURL is an internal function to be used by provider clients.
 //
 // It ensures that each endpoint URL has a closing `/`, as expected by